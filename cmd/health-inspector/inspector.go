@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/palantir/pkg/safejson"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+const (
+	exitCodeUnknownState = 7
+	exitCodeUnreachable  = 8
+	exitCodeUsage        = 9
+)
+
+type inspectorConfig struct {
+	url      string
+	secret   string
+	json     bool
+	watch    time.Duration
+	timeout  time.Duration
+	colorize bool
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	conf, err := parseFlags(args, stderr)
+	if err != nil {
+		return exitCodeUsage
+	}
+	client := &http.Client{Timeout: conf.timeout}
+
+	var previous *health.HealthStatus
+	for {
+		exitCode := inspect(ctx, client, conf, previous, stdout, stderr)
+		if conf.watch <= 0 {
+			return exitCode.code
+		}
+		if exitCode.healthStatus != nil {
+			previous = exitCode.healthStatus
+		}
+		select {
+		case <-ctx.Done():
+			return exitCode.code
+		case <-time.After(conf.watch):
+		}
+	}
+}
+
+func parseFlags(args []string, stderr io.Writer) (inspectorConfig, error) {
+	flags := flag.NewFlagSet("health-inspector", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: health-inspector [flags] URL")
+		flags.PrintDefaults()
+	}
+	var conf inspectorConfig
+	var noColor bool
+	flags.StringVar(&conf.secret, "secret", os.Getenv("HEALTH_INSPECTOR_SECRET"), "shared secret sent as a bearer token; defaults to $HEALTH_INSPECTOR_SECRET")
+	flags.BoolVar(&conf.json, "json", false, "print the health status as JSON instead of a table")
+	flags.DurationVar(&conf.watch, "watch", 0, "refresh the health status at this interval and highlight transitions")
+	flags.DurationVar(&conf.timeout, "timeout", 10*time.Second, "timeout of each request to the health endpoint")
+	flags.BoolVar(&noColor, "no-color", os.Getenv("NO_COLOR") != "", "disable colored output")
+	if err := flags.Parse(args); err != nil {
+		return inspectorConfig{}, err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return inspectorConfig{}, werror.Error("exactly one URL must be provided")
+	}
+	conf.url = flags.Arg(0)
+	conf.colorize = !noColor
+	return conf, nil
+}
+
+type inspection struct {
+	code         int
+	healthStatus *health.HealthStatus
+}
+
+func inspect(ctx context.Context, client *http.Client, conf inspectorConfig, previous *health.HealthStatus, stdout, stderr io.Writer) inspection {
+	healthStatus, err := fetchHealthStatus(ctx, client, conf.url, conf.secret)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "failed to fetch health status from %s: %v\n", conf.url, err)
+		return inspection{code: exitCodeUnreachable}
+	}
+	if conf.json {
+		if err := writeJSON(stdout, healthStatus, conf.watch > 0); err != nil {
+			_, _ = fmt.Fprintf(stderr, "failed to encode health status: %v\n", err)
+		}
+	} else {
+		if conf.watch > 0 {
+			_, _ = fmt.Fprintf(stdout, "%s\n", time.Now().Format(time.RFC3339))
+		}
+		writeTable(stdout, healthStatus, previous, conf.colorize)
+	}
+	return inspection{
+		code:         exitCode(healthStatus),
+		healthStatus: &healthStatus,
+	}
+}
+
+func fetchHealthStatus(ctx context.Context, client *http.Client, url, secret string) (health.HealthStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return health.HealthStatus{}, werror.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if secret != "" {
+		req.Header.Set("Authorization", "Bearer "+secret)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return health.HealthStatus{}, werror.Wrap(err, "request failed")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	return status.DecodeHealthResponse(resp)
+}
+
+// exitCode maps the status.WorstHealthState of healthStatus to the exit code of the command. Unknown states are the
+// most severe, so a check in an unknown state always results in exitCodeUnknownState.
+func exitCode(healthStatus health.HealthStatus) int {
+	worst := status.WorstHealthState(healthStatus)
+	if worst.IsUnknown() {
+		return exitCodeUnknownState
+	}
+	switch code := status.HealthStateStatusCode(worst.Value()); {
+	case code == http.StatusOK:
+		return 0
+	case code >= status.HealthStateStatusCode(health.HealthState_DEFERRING) && code <= status.HealthStateStatusCode(health.HealthState_TERMINAL):
+		return code - status.HealthStateStatusCode(health.HealthState_DEFERRING) + 1
+	default:
+		return exitCodeUnknownState
+	}
+}
+
+func writeJSON(w io.Writer, healthStatus health.HealthStatus, compact bool) error {
+	body, err := safejson.Marshal(healthStatus)
+	if err != nil {
+		return err
+	}
+	if !compact {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, body, "", "  "); err != nil {
+			return err
+		}
+		body = indented.Bytes()
+	}
+	_, err = fmt.Fprintf(w, "%s\n", body)
+	return err
+}
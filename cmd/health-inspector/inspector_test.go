@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func healthServer(t *testing.T, statuses ...health.HealthStatus) *httptest.Server {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		idx := int(atomic.AddInt32(&requests, 1)) - 1
+		if idx >= len(statuses) {
+			idx = len(statuses) - 1
+		}
+		body, err := safejson.Marshal(statuses[idx])
+		require.NoError(t, err)
+		w.WriteHeader(status.HealthStatusCode(statuses[idx]))
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func checks(results ...health.HealthCheckResult) health.HealthStatus {
+	healthStatus := health.HealthStatus{Checks: make(map[health.CheckType]health.HealthCheckResult)}
+	for _, result := range results {
+		healthStatus.Checks[result.Type] = result
+	}
+	return healthStatus
+}
+
+func check(checkType health.CheckType, state health.HealthState_Value, message string) health.HealthCheckResult {
+	result := health.HealthCheckResult{
+		Type:  checkType,
+		State: health.New_HealthState(state),
+	}
+	if message != "" {
+		result.Message = &message
+	}
+	return result
+}
+
+func TestRun_Table(t *testing.T) {
+	server := healthServer(t, checks(
+		check("HEALTHY_CHECK", health.HealthState_HEALTHY, ""),
+		check("ERROR_CHECK", health.HealthState_ERROR, "broken"),
+		check("WARNING_CHECK", health.HealthState_WARNING, "degraded"),
+	))
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"-no-color", "-secret", "secret", server.URL}, &stdout, &stderr)
+	assert.Equal(t, 5, code)
+	assert.Equal(t, strings.Join([]string{
+		"  CHECK TYPE     STATE    MESSAGE",
+		"  ERROR_CHECK    ERROR    broken",
+		"  WARNING_CHECK  WARNING  degraded",
+		"  HEALTHY_CHECK  HEALTHY",
+		"",
+	}, "\n"), stdout.String())
+	assert.Empty(t, stderr.String())
+}
+
+func TestRun_JSON(t *testing.T) {
+	result := check("HEALTHY_CHECK", health.HealthState_HEALTHY, "ok")
+	result.Params = map[string]interface{}{"key": "value"}
+	expected := checks(result)
+	server := healthServer(t, expected)
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"-json", "-secret", "secret", server.URL}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	var actual health.HealthStatus
+	require.NoError(t, safejson.Unmarshal(stdout.Bytes(), &actual))
+	assert.Equal(t, expected, actual)
+}
+
+func TestRun_Watch(t *testing.T) {
+	server := healthServer(t,
+		checks(check("A_CHECK", health.HealthState_HEALTHY, ""), check("B_CHECK", health.HealthState_HEALTHY, "")),
+		checks(check("A_CHECK", health.HealthState_TERMINAL, "gone")),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	code := run(ctx, []string{"-no-color", "-watch", "100ms", "-secret", "secret", server.URL}, &stdout, &stderr)
+	assert.Equal(t, 6, code)
+	assert.Contains(t, stdout.String(), "* A_CHECK     HEALTHY -> TERMINAL  gone\n")
+	assert.Contains(t, stdout.String(), "* B_CHECK     HEALTHY -> ABSENT\n")
+}
+
+func TestRun_Errors(t *testing.T) {
+	server := healthServer(t, checks())
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, exitCodeUnreachable, run(context.Background(), []string{server.URL}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "failed to fetch health status")
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("<html>login page</html>"))
+	}))
+	defer proxy.Close()
+	assert.Equal(t, exitCodeUnreachable, run(context.Background(), []string{proxy.URL}, &stdout, &stderr))
+
+	assert.Equal(t, exitCodeUsage, run(context.Background(), nil, &stdout, &stderr))
+	assert.Equal(t, exitCodeUsage, run(context.Background(), []string{"-unknown", server.URL}, &stdout, &stderr))
+}
+
+func TestExitCode(t *testing.T) {
+	for state, expected := range map[health.HealthState_Value]int{
+		health.HealthState_HEALTHY:   0,
+		health.HealthState_DEFERRING: 1,
+		health.HealthState_SUSPENDED: 2,
+		health.HealthState_REPAIRING: 3,
+		health.HealthState_WARNING:   4,
+		health.HealthState_ERROR:     5,
+		health.HealthState_TERMINAL:  6,
+		"BROKEN":                     exitCodeUnknownState,
+	} {
+		assert.Equal(t, expected, exitCode(checks(check("CHECK", state, ""))), string(state))
+	}
+	assert.Equal(t, 0, exitCode(checks()))
+	assert.Equal(t, exitCodeUnknownState, exitCode(checks(
+		check("DEFERRING_CHECK", health.HealthState_DEFERRING, ""),
+		check("UNKNOWN_CHECK", "BROKEN", ""),
+	)))
+	assert.Equal(t, exitCodeUnknownState, exitCode(checks(
+		check("TERMINAL_CHECK", health.HealthState_TERMINAL, ""),
+		check("UNKNOWN_CHECK", "BROKEN", ""),
+	)))
+}
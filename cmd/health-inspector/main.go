@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command health-inspector fetches the health status served by an SLS health endpoint and renders it as a table
+// sorted by severity.
+//
+// Usage:
+//
+//	health-inspector [flags] URL
+//
+// The exit code reflects the status.HealthStatusCode of the fetched health status: 0 if every check is HEALTHY,
+// 1 through 6 if the worst check is DEFERRING, SUSPENDED, REPAIRING, WARNING, ERROR or TERMINAL respectively, 7 if a
+// check has an unrecognized state, 8 if the health status could not be fetched and 9 if the flags are invalid.
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiYellow  = "\x1b[33m"
+	ansiBlue    = "\x1b[34m"
+	ansiMagenta = "\x1b[35m"
+)
+
+var stateColors = map[health.HealthState_Value]string{
+	health.HealthState_HEALTHY:   ansiGreen,
+	health.HealthState_DEFERRING: ansiBlue,
+	health.HealthState_SUSPENDED: ansiBlue,
+	health.HealthState_REPAIRING: ansiYellow,
+	health.HealthState_WARNING:   ansiYellow,
+	health.HealthState_ERROR:     ansiRed,
+	health.HealthState_TERMINAL:  ansiMagenta,
+}
+
+type tableRow struct {
+	checkType  string
+	state      string
+	stateValue health.HealthState_Value
+	message    string
+	changed    bool
+}
+
+// writeTable writes the checks of healthStatus sorted from most to least severe. If previous is non-nil, checks whose
+// state changed since previous are marked and show their previous state, and removed checks are listed as ABSENT.
+func writeTable(w io.Writer, healthStatus health.HealthStatus, previous *health.HealthStatus, colorize bool) {
+	transitions := make(map[health.CheckType]status.CheckTransition)
+	var removed []status.CheckTransition
+	if previous != nil {
+		for _, transition := range status.Diff(*previous, healthStatus) {
+			transitions[transition.CheckType] = transition
+			if transition.Removed() {
+				removed = append(removed, transition)
+			}
+		}
+	}
+
+	results := make([]health.HealthCheckResult, 0, len(healthStatus.Checks))
+	for _, result := range healthStatus.Checks {
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if cmp := status.CompareHealthStates(results[i].State, results[j].State); cmp != 0 {
+			return cmp > 0
+		}
+		return results[i].Type < results[j].Type
+	})
+
+	rows := make([]tableRow, 0, len(results)+len(removed))
+	for _, result := range results {
+		row := tableRow{
+			checkType:  string(result.Type),
+			state:      result.State.String(),
+			stateValue: result.State.Value(),
+		}
+		if result.Message != nil {
+			row.message = *result.Message
+		}
+		if transition, ok := transitions[result.Type]; ok && transition.StateChanged && transition.OldState != nil {
+			row.state = transition.OldState.String() + " -> " + row.state
+			row.changed = true
+		} else if ok && transition.Added() {
+			row.state = "NEW -> " + row.state
+			row.changed = true
+		}
+		rows = append(rows, row)
+	}
+	for _, transition := range removed {
+		rows = append(rows, tableRow{
+			checkType:  string(transition.CheckType),
+			state:      transition.OldState.String() + " -> ABSENT",
+			stateValue: health.HealthState_HEALTHY,
+			changed:    true,
+		})
+	}
+
+	checkTypeWidth, stateWidth := len("CHECK TYPE"), len("STATE")
+	for _, row := range rows {
+		if len(row.checkType) > checkTypeWidth {
+			checkTypeWidth = len(row.checkType)
+		}
+		if len(row.state) > stateWidth {
+			stateWidth = len(row.state)
+		}
+	}
+
+	_, _ = fmt.Fprintf(w, "  %-*s  %-*s  %s\n", checkTypeWidth, "CHECK TYPE", stateWidth, "STATE", "MESSAGE")
+	for _, row := range rows {
+		marker := " "
+		if row.changed {
+			marker = "*"
+		}
+		state := fmt.Sprintf("%-*s", stateWidth, row.state)
+		if colorize {
+			color, ok := stateColors[row.stateValue]
+			if !ok {
+				color = ansiMagenta
+			}
+			if row.changed {
+				color += ansiBold
+			}
+			state = color + state + ansiReset
+		}
+		line := fmt.Sprintf("%s %-*s  %s  %s", marker, checkTypeWidth, row.checkType, state, row.message)
+		_, _ = fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/pkg/safejson"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+const (
+	// CheckTypeQueryParam restricts the response to the provided check types. It may be repeated or contain a
+	// comma-separated list of check types.
+	CheckTypeQueryParam = "checkType"
+	// MinStateQueryParam restricts the response to checks that are at least as severe as the provided health state.
+	MinStateQueryParam = "minState"
+)
+
+type healthHandler struct {
+	source          status.HealthCheckSource
+	sharedSecret    string
+	redactionPolicy status.RedactionPolicy
+}
+
+// NewHealthHandler returns an http.Handler that serves the health status of the provided source as an SLS health
+// response. The response code is the status.HealthStatusCode of the returned checks.
+//
+// If source is a status.SnapshotHealthCheckSource, responses carry a weak ETag derived from the generation of the
+// source, and requests with a matching If-None-Match header are answered with http.StatusNotModified without
+// computing the health status.
+func NewHealthHandler(source status.HealthCheckSource, options ...HealthOption) http.Handler {
+	conf := defaultHealthHandlerConfig()
+	conf.apply(options...)
+	return &healthHandler{
+		source:          source,
+		sharedSecret:    conf.sharedSecret,
+		redactionPolicy: conf.redactionPolicy,
+	}
+}
+
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if h.sharedSecret != "" && !authorized(req, h.sharedSecret) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	filter, err := newHealthStatusFilter(req)
+	if err != nil {
+		http.Error(w, "invalid "+MinStateQueryParam+" query parameter", http.StatusBadRequest)
+		return
+	}
+	var healthStatus health.HealthStatus
+	if snapshotSource, ok := h.source.(status.SnapshotHealthCheckSource); ok {
+		if etag := generationETag(snapshotSource.Generation()); etagMatches(req.Header.Get("If-None-Match"), etag) {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		snapshot := snapshotSource.HealthStatusSnapshot(req.Context())
+		w.Header().Set("ETag", generationETag(snapshot.Generation))
+		healthStatus = snapshot.HealthStatus
+	} else {
+		healthStatus = h.source.HealthStatus(req.Context())
+	}
+	healthStatus = status.RedactHealthStatus(h.redactionPolicy, filter.apply(healthStatus))
+	writeJSON(w, status.HealthStatusCode(healthStatus), healthStatus)
+}
+
+func generationETag(generation uint64) string {
+	return `W/"` + strconv.FormatUint(generation, 10) + `"`
+}
+
+// etagMatches returns whether the If-None-Match header value matches etag using the weak comparison.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// authorized returns whether req provides sharedSecret as a bearer token in its Authorization header.
+func authorized(req *http.Request, sharedSecret string) bool {
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(sharedSecret)) == 1
+}
+
+type healthStatusFilter struct {
+	checkTypes map[health.CheckType]struct{}
+	minState   *health.HealthState
+}
+
+func newHealthStatusFilter(req *http.Request) (healthStatusFilter, error) {
+	var filter healthStatusFilter
+	query := req.URL.Query()
+	for _, value := range query[CheckTypeQueryParam] {
+		for _, checkType := range strings.Split(value, ",") {
+			if checkType == "" {
+				continue
+			}
+			if filter.checkTypes == nil {
+				filter.checkTypes = make(map[health.CheckType]struct{})
+			}
+			filter.checkTypes[health.CheckType(checkType)] = struct{}{}
+		}
+	}
+	if minState := query.Get(MinStateQueryParam); minState != "" {
+		var state health.HealthState
+		if err := state.UnmarshalText([]byte(minState)); err != nil || state.IsUnknown() {
+			return healthStatusFilter{}, werror.Error("invalid health state for query parameter",
+				werror.SafeParam("param", MinStateQueryParam),
+				werror.SafeParam("value", minState))
+		}
+		filter.minState = &state
+	}
+	return filter, nil
+}
+
+func (f healthStatusFilter) apply(healthStatus health.HealthStatus) health.HealthStatus {
+	if f.checkTypes == nil && f.minState == nil {
+		return healthStatus
+	}
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(healthStatus.Checks))
+	for checkType, result := range healthStatus.Checks {
+		if f.checkTypes != nil {
+			if _, ok := f.checkTypes[checkType]; !ok {
+				continue
+			}
+		}
+		if f.minState != nil && status.CompareHealthStates(result.State, *f.minState) < 0 {
+			continue
+		}
+		checks[checkType] = result
+	}
+	return health.HealthStatus{Checks: checks}
+}
+
+func writeJSON(w http.ResponseWriter, respStatus int, value interface{}) {
+	body, err := safejson.Marshal(value)
+	if err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(respStatus)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/reporter"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testHealthCheckSource struct {
+	healthStatus health.HealthStatus
+}
+
+func (t *testHealthCheckSource) HealthStatus(_ context.Context) health.HealthStatus {
+	return t.healthStatus
+}
+
+var testSource = &testHealthCheckSource{
+	healthStatus: health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"HEALTHY_CHECK": {
+				Type:  "HEALTHY_CHECK",
+				State: health.New_HealthState(health.HealthState_HEALTHY),
+			},
+			"WARNING_CHECK": {
+				Type:  "WARNING_CHECK",
+				State: health.New_HealthState(health.HealthState_WARNING),
+			},
+			"ERROR_CHECK": {
+				Type:  "ERROR_CHECK",
+				State: health.New_HealthState(health.HealthState_ERROR),
+			},
+		},
+	},
+}
+
+func TestHealthHandler(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		target         string
+		options        []HealthOption
+		authorization  string
+		expectedStatus int
+		expectedChecks []health.CheckType
+	}{
+		{
+			name:           "returns all checks with worst status code",
+			target:         "/health",
+			expectedStatus: 522,
+			expectedChecks: []health.CheckType{"HEALTHY_CHECK", "WARNING_CHECK", "ERROR_CHECK"},
+		},
+		{
+			name:           "filters by check type",
+			target:         "/health?checkType=HEALTHY_CHECK&checkType=WARNING_CHECK",
+			expectedStatus: 521,
+			expectedChecks: []health.CheckType{"HEALTHY_CHECK", "WARNING_CHECK"},
+		},
+		{
+			name:           "filters by comma separated check types",
+			target:         "/health?checkType=HEALTHY_CHECK,UNKNOWN_CHECK",
+			expectedStatus: http.StatusOK,
+			expectedChecks: []health.CheckType{"HEALTHY_CHECK"},
+		},
+		{
+			name:           "filters by minimum state",
+			target:         "/health?minState=warning",
+			expectedStatus: 522,
+			expectedChecks: []health.CheckType{"WARNING_CHECK", "ERROR_CHECK"},
+		},
+		{
+			name:           "combines filters",
+			target:         "/health?minState=WARNING&checkType=HEALTHY_CHECK,WARNING_CHECK",
+			expectedStatus: 521,
+			expectedChecks: []health.CheckType{"WARNING_CHECK"},
+		},
+		{
+			name:           "rejects invalid minimum state",
+			target:         "/health?minState=BROKEN",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "rejects missing shared secret",
+			target:         "/health",
+			options:        []HealthOption{WithSharedSecret("secret")},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "rejects incorrect shared secret",
+			target:         "/health",
+			options:        []HealthOption{WithSharedSecret("secret")},
+			authorization:  "Bearer other",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "accepts correct shared secret",
+			target:         "/health",
+			options:        []HealthOption{WithSharedSecret("secret")},
+			authorization:  "Bearer secret",
+			expectedStatus: 522,
+			expectedChecks: []health.CheckType{"HEALTHY_CHECK", "WARNING_CHECK", "ERROR_CHECK"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			NewHealthHandler(testSource, tc.options...).ServeHTTP(rec, req)
+			require.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedChecks == nil {
+				return
+			}
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			var healthStatus health.HealthStatus
+			require.NoError(t, safejson.Unmarshal(rec.Body.Bytes(), &healthStatus))
+			var checkTypes []health.CheckType
+			for checkType := range healthStatus.Checks {
+				checkTypes = append(checkTypes, checkType)
+			}
+			assert.ElementsMatch(t, tc.expectedChecks, checkTypes)
+		})
+	}
+}
+
+func TestHealthHandlerMinStateIncludesUnknownStates(t *testing.T) {
+	source := &testHealthCheckSource{
+		healthStatus: health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				"ERROR_CHECK":   {Type: "ERROR_CHECK", State: health.New_HealthState(health.HealthState_ERROR)},
+				"UNKNOWN_CHECK": {Type: "UNKNOWN_CHECK", State: health.New_HealthState("FUTURE")},
+			},
+		},
+	}
+	rec := httptest.NewRecorder()
+	NewHealthHandler(source).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health?minState=TERMINAL", nil))
+	var healthStatus health.HealthStatus
+	require.NoError(t, safejson.Unmarshal(rec.Body.Bytes(), &healthStatus))
+	assert.Equal(t, []health.CheckType{"UNKNOWN_CHECK"}, checkTypesOf(healthStatus))
+}
+
+func checkTypesOf(healthStatus health.HealthStatus) []health.CheckType {
+	var checkTypes []health.CheckType
+	for checkType := range healthStatus.Checks {
+		checkTypes = append(checkTypes, checkType)
+	}
+	return checkTypes
+}
+
+func TestHealthHandlerRedaction(t *testing.T) {
+	source := &testHealthCheckSource{
+		healthStatus: health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				"ERROR_CHECK": {
+					Type:  "ERROR_CHECK",
+					State: health.New_HealthState(health.HealthState_ERROR),
+					Params: map[string]interface{}{
+						"safe":   "value",
+						"unsafe": status.NewUnsafeParam("secret value"),
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range []struct {
+		name           string
+		options        []HealthOption
+		expectedParams map[string]interface{}
+	}{
+		{
+			name:           "drops unsafe params by default",
+			expectedParams: map[string]interface{}{"safe": "value"},
+		},
+		{
+			name:           "keeps unsafe params if configured",
+			options:        []HealthOption{WithRedactionPolicy(status.KeepUnsafeParams)},
+			expectedParams: map[string]interface{}{"safe": "value", "unsafe": "secret value"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			NewHealthHandler(source, tc.options...).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+			var healthStatus health.HealthStatus
+			require.NoError(t, safejson.Unmarshal(rec.Body.Bytes(), &healthStatus))
+			assert.Equal(t, tc.expectedParams, healthStatus.Checks["ERROR_CHECK"].Params)
+		})
+	}
+}
+
+type testSnapshotHealthCheckSource struct {
+	testHealthCheckSource
+	generation uint64
+	calls      int
+}
+
+func (t *testSnapshotHealthCheckSource) Generation() uint64 {
+	return t.generation
+}
+
+func (t *testSnapshotHealthCheckSource) HealthStatusSnapshot(ctx context.Context) status.HealthStatusSnapshot {
+	t.calls++
+	return status.HealthStatusSnapshot{
+		Generation:   t.generation,
+		HealthStatus: t.HealthStatus(ctx),
+	}
+}
+
+func TestHealthHandlerETag(t *testing.T) {
+	source := &testSnapshotHealthCheckSource{
+		testHealthCheckSource: *testSource,
+		generation:            42,
+	}
+	handler := NewHealthHandler(source)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, 522, rec.Code)
+	assert.Equal(t, `W/"42"`, rec.Header().Get("ETag"))
+	assert.Equal(t, 1, source.calls)
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set("If-None-Match", `W/"41", W/"42"`)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.Bytes())
+	assert.Equal(t, 1, source.calls)
+
+	source.generation = 43
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, 522, rec.Code)
+	assert.Equal(t, `W/"43"`, rec.Header().Get("ETag"))
+	assert.Equal(t, 2, source.calls)
+}
+
+func TestHealthHandlerETagOnExpiry(t *testing.T) {
+	healthReporter := reporter.NewHealthReporter()
+	component, err := healthReporter.InitializeHealthComponent("COMPONENT", reporter.WithHealthStateTTL(20*time.Millisecond, health.HealthState_ERROR))
+	require.NoError(t, err)
+	component.Healthy()
+	handler := NewHealthHandler(healthReporter)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+
+	time.Sleep(50 * time.Millisecond)
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, 522, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+}
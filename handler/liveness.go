@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+// LivenessPolicy returns whether a service with the provided health status should be considered live.
+type LivenessPolicy func(healthStatus health.HealthStatus) bool
+
+// LiveUnlessAnyCheckInState returns a LivenessPolicy that considers the service live unless any check is in one of
+// the provided states.
+func LiveUnlessAnyCheckInState(states ...health.HealthState_Value) LivenessPolicy {
+	return func(healthStatus health.HealthStatus) bool {
+		for _, result := range healthStatus.Checks {
+			for _, state := range states {
+				if result.State.Value() == state {
+					return false
+				}
+			}
+		}
+		return true
+	}
+}
+
+// LiveUnlessAnyTerminal returns a LivenessPolicy that considers the service live unless any check is
+// health.HealthState_TERMINAL.
+func LiveUnlessAnyTerminal() LivenessPolicy {
+	return LiveUnlessAnyCheckInState(health.HealthState_TERMINAL)
+}
+
+type livenessHandler struct {
+	source          status.HealthCheckSource
+	policy          LivenessPolicy
+	sharedSecret    string
+	redactionPolicy status.RedactionPolicy
+}
+
+// LivenessResponse is the body of a liveness response without details. It maps the check types of the checks that are
+// not health.HealthState_HEALTHY to their states.
+type LivenessResponse struct {
+	Checks map[health.CheckType]health.HealthState `json:"checks"`
+}
+
+// NewLivenessHandler returns an http.Handler that serves a liveness probe derived from the health status of the
+// provided source. The response code is http.StatusOK if the policy considers the service live and
+// http.StatusServiceUnavailable otherwise. If policy is nil, LiveUnlessAnyTerminal is used.
+//
+// The response body is a LivenessResponse, since probes only need the response code. If a shared secret is provided
+// using WithSharedSecret, requests that provide it get the full health status in the response body instead, with
+// unsafe params treated according to the redaction policy.
+func NewLivenessHandler(source status.HealthCheckSource, policy LivenessPolicy, options ...HealthOption) http.Handler {
+	if policy == nil {
+		policy = LiveUnlessAnyTerminal()
+	}
+	conf := defaultHealthHandlerConfig()
+	conf.apply(options...)
+	return &livenessHandler{
+		source:          source,
+		policy:          policy,
+		sharedSecret:    conf.sharedSecret,
+		redactionPolicy: conf.redactionPolicy,
+	}
+}
+
+func (h *livenessHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	healthStatus := h.source.HealthStatus(req.Context())
+	respStatus := http.StatusOK
+	if !h.policy(healthStatus) {
+		respStatus = http.StatusServiceUnavailable
+	}
+	if h.sharedSecret != "" && authorized(req, h.sharedSecret) {
+		writeJSON(w, respStatus, status.RedactHealthStatus(h.redactionPolicy, healthStatus))
+		return
+	}
+	response := LivenessResponse{Checks: make(map[health.CheckType]health.HealthState)}
+	for checkType, result := range healthStatus.Checks {
+		if result.State.Value() != health.HealthState_HEALTHY {
+			response.Checks[checkType] = result.State
+		}
+	}
+	writeJSON(w, respStatus, response)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLivenessHandler(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		state          health.HealthState_Value
+		policy         LivenessPolicy
+		expectedStatus int
+	}{
+		{
+			name:           "default policy is live when error",
+			state:          health.HealthState_ERROR,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "default policy is not live when terminal",
+			state:          health.HealthState_TERMINAL,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "custom policy is not live when error",
+			state:          health.HealthState_ERROR,
+			policy:         LiveUnlessAnyCheckInState(health.HealthState_ERROR, health.HealthState_TERMINAL),
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "custom policy is live when warning",
+			state:          health.HealthState_WARNING,
+			policy:         LiveUnlessAnyCheckInState(health.HealthState_ERROR, health.HealthState_TERMINAL),
+			expectedStatus: http.StatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			source := &testHealthCheckSource{
+				healthStatus: health.HealthStatus{
+					Checks: map[health.CheckType]health.HealthCheckResult{
+						"TEST_CHECK": {
+							Type:  "TEST_CHECK",
+							State: health.New_HealthState(tc.state),
+						},
+					},
+				},
+			}
+			rec := httptest.NewRecorder()
+			NewLivenessHandler(source, tc.policy).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/liveness", nil))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), string(tc.state))
+		})
+	}
+}
+
+func TestLivenessHandlerDetails(t *testing.T) {
+	message := "customer@example.com not found"
+	source := &testHealthCheckSource{
+		healthStatus: health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				"HEALTHY_CHECK": {
+					Type:  "HEALTHY_CHECK",
+					State: health.New_HealthState(health.HealthState_HEALTHY),
+				},
+				"TERMINAL_CHECK": {
+					Type:    "TERMINAL_CHECK",
+					State:   health.New_HealthState(health.HealthState_TERMINAL),
+					Message: &message,
+					Params: map[string]interface{}{
+						"safe":   "value",
+						"unsafe": status.NewUnsafeParam("secret value"),
+					},
+				},
+			},
+		},
+	}
+	handler := NewLivenessHandler(source, nil, WithSharedSecret("secret"))
+
+	for _, authorization := range []string{"", "Bearer other"} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/liveness", nil)
+		req.Header.Set("Authorization", authorization)
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.JSONEq(t, `{"checks":{"TERMINAL_CHECK":"TERMINAL"}}`, rec.Body.String())
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/liveness", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var healthStatus health.HealthStatus
+	require.NoError(t, safejson.Unmarshal(rec.Body.Bytes(), &healthStatus))
+	assert.Len(t, healthStatus.Checks, 2)
+	assert.Equal(t, message, *healthStatus.Checks["TERMINAL_CHECK"].Message)
+	assert.Equal(t, map[string]interface{}{"safe": "value"}, healthStatus.Checks["TERMINAL_CHECK"].Params)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+type HealthOption func(conf *healthHandlerConfig)
+
+type healthHandlerConfig struct {
+	sharedSecret    string
+	redactionPolicy status.RedactionPolicy
+}
+
+func defaultHealthHandlerConfig() healthHandlerConfig {
+	return healthHandlerConfig{
+		sharedSecret:    "",
+		redactionPolicy: status.DropUnsafeParams,
+	}
+}
+
+func (h *healthHandlerConfig) apply(options ...HealthOption) {
+	for _, option := range options {
+		option(h)
+	}
+}
+
+// WithSharedSecret requires requests to provide the shared secret as a bearer token in the Authorization header.
+// Requests without a matching token are rejected with http.StatusUnauthorized. An empty secret disables the check.
+func WithSharedSecret(sharedSecret string) HealthOption {
+	return func(conf *healthHandlerConfig) {
+		conf.sharedSecret = sharedSecret
+	}
+}
+
+// WithRedactionPolicy sets the policy applied to unsafe params before the health status is written to the response.
+// Defaults to status.DropUnsafeParams.
+func WithRedactionPolicy(policy status.RedactionPolicy) HealthOption {
+	return func(conf *healthHandlerConfig) {
+		conf.redactionPolicy = policy
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+type readinessHandler struct {
+	source status.Source
+}
+
+// NewReadinessHandler returns an http.Handler that serves the status of the provided source, typically a
+// reporter.Reporter, as a readiness probe. The response code is the code returned by the source and the metadata is
+// JSON-encoded in the response body.
+func NewReadinessHandler(source status.Source) http.Handler {
+	return &readinessHandler{
+		source: source,
+	}
+}
+
+func (h *readinessHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	respStatus, metadata := h.source.Status()
+	writeJSON(w, respStatus, metadata)
+}
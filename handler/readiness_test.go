@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-health/reporter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	ctx := context.Background()
+	readinessReporter := reporter.NewReadinessReporter()
+	component, err := readinessReporter.InitializeReadinessComponent(ctx, "TEST_COMPONENT")
+	require.NoError(t, err)
+	component.SetStatus(http.StatusServiceUnavailable, "starting")
+
+	rec := httptest.NewRecorder()
+	NewReadinessHandler(readinessReporter).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readiness", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"TEST_COMPONENT":"starting"}`, rec.Body.String())
+
+	component.SetStatus(http.StatusOK, map[string]interface{}{"loaded": true})
+
+	rec = httptest.NewRecorder()
+	NewReadinessHandler(readinessReporter).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readiness", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"TEST_COMPONENT":{"loaded":true}}`, rec.Body.String())
+}
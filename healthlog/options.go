@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthlog
+
+import (
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+type Option func(conf *transitionLoggerConfig)
+
+const defaultMinLogInterval = time.Minute
+
+type transitionLoggerConfig struct {
+	minLogInterval time.Duration
+	severity       status.HealthStateSeverity
+}
+
+func defaultTransitionLoggerConfig() transitionLoggerConfig {
+	return transitionLoggerConfig{
+		minLogInterval: defaultMinLogInterval,
+		severity:       status.DefaultHealthStateSeverity,
+	}
+}
+
+func (t *transitionLoggerConfig) apply(options ...Option) {
+	for _, option := range options {
+		option(t)
+	}
+}
+
+// WithMinLogInterval sets the minimum interval between logged transitions of the same check type. Transitions that
+// occur within the interval are counted and reported on the next logged transition of that check type. Once the
+// interval has elapsed, the latest suppressed state is logged unless the check is back in the state it was last logged
+// in. A non-positive interval logs every transition.
+func WithMinLogInterval(minLogInterval time.Duration) Option {
+	return func(conf *transitionLoggerConfig) {
+		conf.minLogInterval = minLogInterval
+	}
+}
+
+// WithHealthStateSeverity sets the severity ordering used to determine whether a transition is logged as a
+// degradation or a recovery.
+func WithHealthStateSeverity(severity status.HealthStateSeverity) Option {
+	return func(conf *transitionLoggerConfig) {
+		conf.severity = severity
+	}
+}
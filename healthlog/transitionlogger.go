@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthlog
+
+import (
+	"sync"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/palantir/witchcraft-go-health/watch"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+)
+
+// TransitionLogger logs health state transitions of checks using a svc1log.Logger. Degradations are logged at WARN
+// level and all other transitions at INFO level. The messages of checks are logged as unsafe params since they may
+// contain sensitive information.
+type TransitionLogger struct {
+	logger         svc1log.Logger
+	minLogInterval time.Duration
+	severity       status.HealthStateSeverity
+
+	// mutex protects access to `checks`.
+	mutex  sync.Mutex
+	checks map[health.CheckType]*checkLogState
+}
+
+type checkLogState struct {
+	// stateSince is when the check entered its current state.
+	stateSince time.Time
+	// lastLogged is when a transition of the check was last logged and loggedState is the state it was logged in.
+	lastLogged  time.Time
+	loggedState *health.HealthState
+	// suppressed is the number of transitions that were not logged since lastLogged.
+	suppressed int
+	// pending is the latest transition that was not logged, along with when it occurred and when the check entered
+	// the state it left. It is flushed by flushTimer once the minimum log interval has elapsed.
+	pending              *status.CheckTransition
+	pendingTime          time.Time
+	pendingPreviousSince time.Time
+	flushTimer           *time.Timer
+}
+
+// NewTransitionLogger returns a TransitionLogger that logs to logger.
+func NewTransitionLogger(logger svc1log.Logger, options ...Option) *TransitionLogger {
+	conf := defaultTransitionLoggerConfig()
+	conf.apply(options...)
+	return &TransitionLogger{
+		logger:         logger,
+		minLogInterval: conf.minLogInterval,
+		severity:       conf.severity,
+		checks:         make(map[health.CheckType]*checkLogState),
+	}
+}
+
+// LogHealthStatusChange logs the state transitions between two health statuses of the same source.
+func (l *TransitionLogger) LogHealthStatusChange(oldStatus, newStatus health.HealthStatus) {
+	now := time.Now()
+	for _, transition := range l.severity.Diff(oldStatus, newStatus) {
+		l.logTransition(transition, now)
+	}
+}
+
+// LogHealthCheckResultChange logs the transition of a check from oldResult to newResult if its state changed.
+func (l *TransitionLogger) LogHealthCheckResultChange(oldResult, newResult health.HealthCheckResult) {
+	l.LogHealthStatusChange(
+		health.HealthStatus{Checks: map[health.CheckType]health.HealthCheckResult{oldResult.Type: oldResult}},
+		health.HealthStatus{Checks: map[health.CheckType]health.HealthCheckResult{newResult.Type: newResult}},
+	)
+}
+
+// LogTransition logs the provided transition if it changed the state of its check.
+func (l *TransitionLogger) LogTransition(transition status.CheckTransition) {
+	l.logTransition(transition, time.Now())
+}
+
+// LogWatcherTransitions logs the state transitions observed by watcher and returns a function that stops logging.
+func LogWatcherTransitions(watcher *watch.Watcher, logger *TransitionLogger) func() {
+	return watcher.SubscribeFunc(func(event watch.Event) {
+		logger.logTransition(event.CheckTransition, event.Time)
+	}, watch.StateChanges())
+}
+
+func (l *TransitionLogger) logTransition(transition status.CheckTransition, transitionTime time.Time) {
+	if !transition.StateChanged {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	state, tracked := l.checks[transition.CheckType]
+	if !tracked {
+		state = &checkLogState{}
+		l.checks[transition.CheckType] = state
+	}
+	previousStateSince := state.stateSince
+	state.stateSince = transitionTime
+
+	if l.minLogInterval > 0 && !state.lastLogged.IsZero() && transitionTime.Sub(state.lastLogged) < l.minLogInterval {
+		state.suppressed++
+		state.pending = &transition
+		state.pendingTime = transitionTime
+		state.pendingPreviousSince = previousStateSince
+		if state.flushTimer == nil {
+			checkType := transition.CheckType
+			state.flushTimer = time.AfterFunc(state.lastLogged.Add(l.minLogInterval).Sub(transitionTime), func() {
+				l.flush(checkType)
+			})
+		}
+		return
+	}
+
+	if state.flushTimer != nil {
+		state.flushTimer.Stop()
+		state.flushTimer = nil
+	}
+	state.pending = nil
+	l.logLocked(state, transition, previousStateSince, transitionTime)
+}
+
+// flush logs the latest suppressed transition of the check, relative to the state the check was last logged in, so
+// that the logs reflect the state of the check once the minimum log interval has elapsed. Nothing is logged if the
+// check is back in the state it was last logged in.
+func (l *TransitionLogger) flush(checkType health.CheckType) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	state, ok := l.checks[checkType]
+	if !ok {
+		return
+	}
+	state.flushTimer = nil
+	pending := state.pending
+	state.pending = nil
+	if pending == nil || stateString(pending.NewState) == stateString(state.loggedState) {
+		return
+	}
+	transition := *pending
+	transition.OldState = state.loggedState
+	transition.Direction = l.direction(transition.OldState, transition.NewState)
+	l.logLocked(state, transition, state.pendingPreviousSince, state.pendingTime)
+}
+
+// logLocked logs transition, which occurred at transitionTime after the check was in its previous state since
+// previousStateSince. The caller must hold the mutex.
+func (l *TransitionLogger) logLocked(state *checkLogState, transition status.CheckTransition, previousStateSince, transitionTime time.Time) {
+	params := map[string]interface{}{
+		"checkType": transition.CheckType,
+		"oldState":  stateString(transition.OldState),
+		"newState":  stateString(transition.NewState),
+	}
+	if !previousStateSince.IsZero() {
+		params["durationInPreviousState"] = transitionTime.Sub(previousStateSince).String()
+	}
+	if state.suppressed > 0 {
+		params["suppressedTransitions"] = state.suppressed
+	}
+	logParams := []svc1log.Param{svc1log.SafeParams(params)}
+	if transition.New != nil && transition.New.Message != nil {
+		logParams = append(logParams, svc1log.UnsafeParam("message", *transition.New.Message))
+	}
+	state.lastLogged = time.Now()
+	if transitionTime.After(state.lastLogged) {
+		state.lastLogged = transitionTime
+	}
+	state.loggedState = transition.NewState
+	state.suppressed = 0
+
+	if transition.Direction == status.Degradation {
+		l.logger.Warn("Health check state degraded.", logParams...)
+	} else {
+		l.logger.Info("Health check state changed.", logParams...)
+	}
+}
+
+// direction returns the direction of a transition from oldState to newState, treating absent states as healthy.
+func (l *TransitionLogger) direction(oldState, newState *health.HealthState) status.TransitionDirection {
+	healthy := health.New_HealthState(health.HealthState_HEALTHY)
+	if oldState == nil {
+		oldState = &healthy
+	}
+	if newState == nil {
+		newState = &healthy
+	}
+	switch l.severity.Compare(*newState, *oldState) {
+	case 1:
+		return status.Degradation
+	case -1:
+		return status.Recovery
+	default:
+		return status.Unchanged
+	}
+}
+
+func stateString(state *health.HealthState) string {
+	if state == nil {
+		return "ABSENT"
+	}
+	return state.String()
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthlog
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logLine struct {
+	level        string
+	message      string
+	params       map[string]interface{}
+	unsafeParams map[string]interface{}
+}
+
+type captureEntry struct {
+	wlog.MapValueEntries
+}
+
+func (*captureEntry) StringValue(string, string)                    {}
+func (*captureEntry) OptionalStringValue(string, string)            {}
+func (*captureEntry) SafeLongValue(string, int64)                   {}
+func (*captureEntry) IntValue(string, int32)                        {}
+func (*captureEntry) StringListValue(string, []string)              {}
+func (*captureEntry) ObjectValue(string, interface{}, reflect.Type) {}
+
+type captureLogger struct {
+	mutex sync.Mutex
+	lines []logLine
+}
+
+func (c *captureLogger) log(level, msg string, params []svc1log.Param) {
+	entry := &captureEntry{}
+	for _, param := range params {
+		svc1log.ApplyParam(param, entry)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lines = append(c.lines, logLine{
+		level:        level,
+		message:      msg,
+		params:       entry.AnyMapValues()[svc1log.ParamsKey],
+		unsafeParams: entry.AnyMapValues()[wlog.UnsafeParamsKey],
+	})
+}
+
+func (c *captureLogger) logLines() []logLine {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]logLine(nil), c.lines...)
+}
+
+func (c *captureLogger) Debug(msg string, params ...svc1log.Param) { c.log("DEBUG", msg, params) }
+func (c *captureLogger) Info(msg string, params ...svc1log.Param)  { c.log("INFO", msg, params) }
+func (c *captureLogger) Warn(msg string, params ...svc1log.Param)  { c.log("WARN", msg, params) }
+func (c *captureLogger) Error(msg string, params ...svc1log.Param) { c.log("ERROR", msg, params) }
+func (c *captureLogger) SetLevel(wlog.LogLevel)                    {}
+
+func result(state health.HealthState_Value, message string) health.HealthCheckResult {
+	return health.HealthCheckResult{
+		Type:    "TEST_CHECK",
+		State:   health.New_HealthState(state),
+		Message: &message,
+	}
+}
+
+func TestTransitionLogger_LogsStateTransitions(t *testing.T) {
+	logger := &captureLogger{}
+	transitionLogger := NewTransitionLogger(logger, WithMinLogInterval(0))
+
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_HEALTHY, ""), result(health.HealthState_ERROR, "broken"))
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_ERROR, "broken"), result(health.HealthState_ERROR, "still broken"))
+	time.Sleep(10 * time.Millisecond)
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_ERROR, "still broken"), result(health.HealthState_HEALTHY, "fixed"))
+
+	require.Len(t, logger.lines, 2)
+	assert.Equal(t, "WARN", logger.lines[0].level)
+	assert.Equal(t, map[string]interface{}{
+		"checkType": health.CheckType("TEST_CHECK"),
+		"oldState":  "HEALTHY",
+		"newState":  "ERROR",
+	}, logger.lines[0].params)
+	assert.Equal(t, map[string]interface{}{"message": "broken"}, logger.lines[0].unsafeParams)
+
+	assert.Equal(t, "INFO", logger.lines[1].level)
+	assert.Equal(t, "ERROR", logger.lines[1].params["oldState"])
+	assert.Equal(t, "HEALTHY", logger.lines[1].params["newState"])
+	assert.Equal(t, "fixed", logger.lines[1].unsafeParams["message"])
+	duration, err := time.ParseDuration(logger.lines[1].params["durationInPreviousState"].(string))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, duration, 10*time.Millisecond)
+}
+
+func TestTransitionLogger_RateLimitsFlapping(t *testing.T) {
+	logger := &captureLogger{}
+	transitionLogger := NewTransitionLogger(logger, WithMinLogInterval(time.Hour))
+
+	for i := 0; i < 5; i++ {
+		transitionLogger.LogHealthCheckResultChange(result(health.HealthState_HEALTHY, ""), result(health.HealthState_ERROR, ""))
+		transitionLogger.LogHealthCheckResultChange(result(health.HealthState_ERROR, ""), result(health.HealthState_HEALTHY, ""))
+	}
+	require.Len(t, logger.lines, 1)
+
+	transitionLogger = NewTransitionLogger(logger, WithMinLogInterval(20*time.Millisecond))
+	logger.lines = nil
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_HEALTHY, ""), result(health.HealthState_ERROR, ""))
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_ERROR, ""), result(health.HealthState_HEALTHY, ""))
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_HEALTHY, ""), result(health.HealthState_ERROR, ""))
+	time.Sleep(30 * time.Millisecond)
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_ERROR, ""), result(health.HealthState_HEALTHY, ""))
+	require.Len(t, logger.lines, 2)
+	assert.Equal(t, 2, logger.lines[1].params["suppressedTransitions"])
+}
+
+func TestTransitionLogger_FlushesSuppressedTransitions(t *testing.T) {
+	logger := &captureLogger{}
+	transitionLogger := NewTransitionLogger(logger, WithMinLogInterval(20*time.Millisecond))
+
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_HEALTHY, ""), result(health.HealthState_ERROR, "broken"))
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_ERROR, "broken"), result(health.HealthState_HEALTHY, "fixed"))
+	require.Len(t, logger.logLines(), 1)
+
+	assert.Eventually(t, func() bool {
+		return len(logger.logLines()) == 2
+	}, time.Second, 5*time.Millisecond)
+	line := logger.logLines()[1]
+	assert.Equal(t, "INFO", line.level)
+	assert.Equal(t, "ERROR", line.params["oldState"])
+	assert.Equal(t, "HEALTHY", line.params["newState"])
+	assert.Equal(t, 1, line.params["suppressedTransitions"])
+	assert.Equal(t, "fixed", line.unsafeParams["message"])
+
+	// a check that returns to its logged state within the interval is not logged again
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_HEALTHY, ""), result(health.HealthState_ERROR, ""))
+	transitionLogger.LogHealthCheckResultChange(result(health.HealthState_ERROR, ""), result(health.HealthState_HEALTHY, ""))
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, logger.logLines(), 2)
+}
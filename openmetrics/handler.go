@@ -0,0 +1,46 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openmetrics
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+type handler struct {
+	source  status.HealthCheckSource
+	options []Option
+}
+
+// NewHandler returns an http.Handler that serves the health status of the provided source as metrics. Responses use
+// the OpenMetrics text format if the request accepts "application/openmetrics-text" and the Prometheus text exposition
+// format otherwise.
+func NewHandler(source status.HealthCheckSource, options ...Option) http.Handler {
+	return &handler{
+		source:  source,
+		options: options,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	format := FormatPrometheus
+	if strings.Contains(req.Header.Get("Accept"), "application/openmetrics-text") {
+		format = FormatOpenMetrics
+	}
+	w.Header().Set("Content-Type", format.ContentType())
+	_ = Write(w, h.source.HealthStatus(req.Context()), format, h.options...)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openmetrics renders health statuses in the Prometheus text exposition format and the OpenMetrics text
+// format so that they can be scraped by Prometheus-compatible monitoring systems.
+package openmetrics
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+const (
+	checkTypeLabel = "check_type"
+	stateLabel     = "state"
+)
+
+// Format is a text format in which metrics can be written.
+type Format int
+
+const (
+	// FormatPrometheus is the Prometheus text exposition format, version 0.0.4.
+	FormatPrometheus Format = iota
+	// FormatOpenMetrics is the OpenMetrics text format, version 1.0.0.
+	FormatOpenMetrics
+)
+
+// ContentType returns the value of the Content-Type header for responses in the format.
+func (f Format) ContentType() string {
+	if f == FormatOpenMetrics {
+		return "application/openmetrics-text; version=1.0.0; charset=utf-8"
+	}
+	return "text/plain; version=0.0.4; charset=utf-8"
+}
+
+// Write writes the provided health status to w as gauges in the provided format.
+//
+// For every check, one "<namespace>_check_state" series is written per health state with the labels "check_type" and
+// "state". The series of the current state of the check has the value 1 and all other series have the value 0. A
+// single "<namespace>_status_code" series holds the status.HealthStatusCode of the health status.
+func Write(w io.Writer, healthStatus health.HealthStatus, format Format, options ...Option) error {
+	conf := defaultExporterConfig()
+	conf.apply(options...)
+
+	checkStateName := metricName(conf.namespace, "check_state")
+	statusCodeName := metricName(conf.namespace, "status_code")
+
+	checkTypes := make([]health.CheckType, 0, len(healthStatus.Checks))
+	for checkType := range healthStatus.Checks {
+		checkTypes = append(checkTypes, checkType)
+	}
+	sort.Slice(checkTypes, func(i, j int) bool {
+		return checkTypes[i] < checkTypes[j]
+	})
+
+	buf := bufio.NewWriter(w)
+	writeHeader(buf, checkStateName, "Whether the health check is in the state.")
+	for _, checkType := range checkTypes {
+		current := healthStatus.Checks[checkType].State
+		states := health.HealthState_Values()
+		if current.IsUnknown() {
+			states = append(states, health.HealthState_Value(current.String()))
+		}
+		for _, state := range states {
+			value := 0
+			if string(state) == current.String() {
+				value = 1
+			}
+			writeSample(buf, checkStateName, [][2]string{
+				{checkTypeLabel, string(checkType)},
+				{stateLabel, string(state)},
+			}, value)
+		}
+	}
+	writeHeader(buf, statusCodeName, "The HTTP status code of the aggregate health status.")
+	writeSample(buf, statusCodeName, nil, status.HealthStatusCode(healthStatus))
+	if format == FormatOpenMetrics {
+		_, _ = buf.WriteString("# EOF\n")
+	}
+	return buf.Flush()
+}
+
+func metricName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "_" + name
+}
+
+func writeHeader(buf *bufio.Writer, name, help string) {
+	_, _ = buf.WriteString("# HELP " + name + " " + help + "\n")
+	_, _ = buf.WriteString("# TYPE " + name + " gauge\n")
+}
+
+func writeSample(buf *bufio.Writer, name string, labels [][2]string, value int) {
+	_, _ = buf.WriteString(name)
+	if len(labels) > 0 {
+		_ = buf.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				_ = buf.WriteByte(',')
+			}
+			_, _ = buf.WriteString(label[0] + `="` + labelValueEscaper.Replace(label[1]) + `"`)
+		}
+		_ = buf.WriteByte('}')
+	}
+	_, _ = buf.WriteString(" " + strconv.Itoa(value) + "\n")
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openmetrics
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testHealthCheckSource struct {
+	healthStatus health.HealthStatus
+}
+
+func (t *testHealthCheckSource) HealthStatus(_ context.Context) health.HealthStatus {
+	return t.healthStatus
+}
+
+var testHealthStatus = health.HealthStatus{
+	Checks: map[health.CheckType]health.HealthCheckResult{
+		"B_CHECK": {
+			Type:  "B_CHECK",
+			State: health.New_HealthState(health.HealthState_WARNING),
+		},
+		"A_CHECK": {
+			Type:  "A_CHECK",
+			State: health.New_HealthState(health.HealthState_HEALTHY),
+		},
+	},
+}
+
+const expectedPrometheusOutput = `# HELP health_check_state Whether the health check is in the state.
+# TYPE health_check_state gauge
+health_check_state{check_type="A_CHECK",state="HEALTHY"} 1
+health_check_state{check_type="A_CHECK",state="DEFERRING"} 0
+health_check_state{check_type="A_CHECK",state="SUSPENDED"} 0
+health_check_state{check_type="A_CHECK",state="REPAIRING"} 0
+health_check_state{check_type="A_CHECK",state="WARNING"} 0
+health_check_state{check_type="A_CHECK",state="ERROR"} 0
+health_check_state{check_type="A_CHECK",state="TERMINAL"} 0
+health_check_state{check_type="B_CHECK",state="HEALTHY"} 0
+health_check_state{check_type="B_CHECK",state="DEFERRING"} 0
+health_check_state{check_type="B_CHECK",state="SUSPENDED"} 0
+health_check_state{check_type="B_CHECK",state="REPAIRING"} 0
+health_check_state{check_type="B_CHECK",state="WARNING"} 1
+health_check_state{check_type="B_CHECK",state="ERROR"} 0
+health_check_state{check_type="B_CHECK",state="TERMINAL"} 0
+# HELP health_status_code The HTTP status code of the aggregate health status.
+# TYPE health_status_code gauge
+health_status_code 521
+`
+
+func TestWrite(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		healthStatus health.HealthStatus
+		format       Format
+		options      []Option
+		expected     string
+	}{
+		{
+			name:         "prometheus format",
+			healthStatus: testHealthStatus,
+			format:       FormatPrometheus,
+			expected:     expectedPrometheusOutput,
+		},
+		{
+			name:         "openmetrics format",
+			healthStatus: testHealthStatus,
+			format:       FormatOpenMetrics,
+			expected:     expectedPrometheusOutput + "# EOF\n",
+		},
+		{
+			name:     "empty health status with custom namespace",
+			format:   FormatPrometheus,
+			options:  []Option{WithNamespace("my_service")},
+			expected: "# HELP my_service_check_state Whether the health check is in the state.\n# TYPE my_service_check_state gauge\n# HELP my_service_status_code The HTTP status code of the aggregate health status.\n# TYPE my_service_status_code gauge\nmy_service_status_code 200\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, tc.healthStatus, tc.format, tc.options...))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestWrite_UnknownStateAndEscaping(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"QUOTED\"CHECK": {
+				Type:  "QUOTED\"CHECK",
+				State: health.New_HealthState("BROKEN"),
+			},
+		},
+	}, FormatPrometheus))
+	assert.NotContains(t, buf.String(), `state="UNKNOWN"`)
+	assert.Contains(t, buf.String(), `health_check_state{check_type="QUOTED\"CHECK",state="BROKEN"} 1`+"\n")
+	assert.Contains(t, buf.String(), "health_status_code 500\n")
+}
+
+func TestHandler(t *testing.T) {
+	source := &testHealthCheckSource{healthStatus: testHealthStatus}
+
+	rec := httptest.NewRecorder()
+	NewHandler(source).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, FormatPrometheus.ContentType(), rec.Header().Get("Content-Type"))
+	assert.Equal(t, expectedPrometheusOutput, rec.Body.String())
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0,text/plain;q=0.5")
+	rec = httptest.NewRecorder()
+	NewHandler(source).ServeHTTP(rec, req)
+	assert.Equal(t, FormatOpenMetrics.ContentType(), rec.Header().Get("Content-Type"))
+	assert.True(t, strings.HasSuffix(rec.Body.String(), "# EOF\n"))
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openmetrics
+
+type Option func(conf *exporterConfig)
+
+type exporterConfig struct {
+	namespace string
+}
+
+func defaultExporterConfig() exporterConfig {
+	return exporterConfig{
+		namespace: "health",
+	}
+}
+
+func (c *exporterConfig) apply(options ...Option) {
+	for _, option := range options {
+		option(c)
+	}
+}
+
+// WithNamespace sets the prefix of the exported metric names. Defaults to "health", which exports the metrics
+// "health_check_state" and "health_status_code".
+func WithNamespace(namespace string) Option {
+	return func(conf *exporterConfig) {
+		conf.namespace = namespace
+	}
+}
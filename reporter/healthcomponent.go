@@ -15,21 +15,48 @@
 package reporter
 
 import (
+	"reflect"
 	"sync"
+	"time"
 
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/healthlog"
+	"github.com/palantir/witchcraft-go-health/status"
 )
 
+// StaleSinceParam is the param of an expired health check result that holds the time at which its state expired.
+const StaleSinceParam = "staleSince"
+
 var _ HealthComponent = &healthComponent{}
 
 // HealthComponent is an extensible component that represents one part of the whole health picture for a service.
 type HealthComponent interface {
 	Healthy()
 	Warning(message string)
+	// Error sets the component to ERROR. If err is a werror, the message of the result is the message of err without
+	// the messages of its causes; otherwise, it is a fixed message and the message of err is reported in the unsafe
+	// ErrorParam param. The params of the result also hold the safe and unsafe params of err, the messages of the
+	// chain of causes of err as an unsafe param and, if configured using WithErrorStack, the stack of err.
 	Error(err error)
+	// WarningWithParams sets the component to WARNING with the provided message and params.
+	WarningWithParams(message string, params map[string]interface{})
+	// ErrorWithParams sets the component to ERROR like Error, with the provided params taking precedence over the
+	// params derived from err.
+	ErrorWithParams(err error, params map[string]interface{})
+	// SetHealth sets the health of the component. Params that may contain sensitive information should be wrapped
+	// using status.NewUnsafeParam so that they can be redacted before the health status leaves the process.
 	SetHealth(healthState health.HealthState_Value, message *string, params map[string]interface{})
+	// SetHealthWithTTL sets the health like SetHealth, but the state expires if it is not set again within ttl.
+	// A non-positive ttl never expires, overriding any TTL configured on the component.
+	SetHealthWithTTL(healthState health.HealthState_Value, message *string, params map[string]interface{}, ttl time.Duration)
 	Status() health.HealthState_Value
 	GetHealthCheck() health.HealthCheckResult
+	// History returns the most recent state transitions of the component from oldest to newest, starting with its
+	// initialization.
+	History() []HealthTransition
+	// AddHook adds a hook that is called with the HealthComponentChanged and HealthComponentUnregistered events of the
+	// component and returns a function that removes the hook.
+	AddHook(hook HealthHook) (remove func())
 }
 
 type healthComponent struct {
@@ -39,6 +66,27 @@ type healthComponent struct {
 	state   health.HealthState
 	message *string
 	params  map[string]interface{}
+	// updated is when the health was last set and ttl is how long the health remains valid after that.
+	updated time.Time
+	ttl     time.Duration
+
+	history *transitionHistory
+
+	defaultTTL       time.Duration
+	expiredState     health.HealthState_Value
+	historySummary   bool
+	errorStackFrames int
+	transitionLogger *healthlog.TransitionLogger
+	// onChange is called while holding the lock whenever the health check result of the component changes.
+	onChange func()
+	// expiryReported is whether the expiry of the current state was reported as a change.
+	expiryReported bool
+
+	hooks healthHooks
+	// hookSets are the hooks of the component, of its reporter and of the scoped reporters through which it was
+	// registered.
+	hookSets   []*healthHooks
+	dispatcher *hookDispatcher
 }
 
 func (r *healthComponent) Healthy() {
@@ -50,17 +98,49 @@ func (r *healthComponent) Warning(warningMsg string) {
 }
 
 func (r *healthComponent) Error(err error) {
-	errorString := err.Error()
-	r.SetHealth(health.HealthState_ERROR, &errorString, nil)
+	r.ErrorWithParams(err, nil)
+}
+
+func (r *healthComponent) WarningWithParams(message string, params map[string]interface{}) {
+	r.SetHealth(health.HealthState_WARNING, &message, params)
+}
+
+func (r *healthComponent) ErrorWithParams(err error, params map[string]interface{}) {
+	message := errorMessage(err)
+	r.SetHealth(health.HealthState_ERROR, &message, errorParams(err, params, r.errorStackFrames))
 }
 
 func (r *healthComponent) SetHealth(healthState health.HealthState_Value, message *string, params map[string]interface{}) {
+	r.SetHealthWithTTL(healthState, message, params, r.defaultTTL)
+}
+
+func (r *healthComponent) SetHealthWithTTL(healthState health.HealthState_Value, message *string, params map[string]interface{}, ttl time.Duration) {
 	r.Lock()
 	defer r.Unlock()
 
+	r.reportExpiryLocked()
+	oldResult := r.healthCheckLocked()
+
+	now := time.Now()
+	r.history.addIfChanged(HealthTransition{
+		Time:    now,
+		State:   healthState,
+		Message: copyStringPtr(message),
+	})
 	r.state = health.New_HealthState(healthState)
 	r.message = message
 	r.params = params
+	r.updated = now
+	r.ttl = ttl
+	r.expiryReported = false
+
+	newResult := r.healthCheckLocked()
+	if r.transitionLogger != nil {
+		r.transitionLogger.LogHealthCheckResultChange(oldResult, newResult)
+	}
+	if !reflect.DeepEqual(oldResult, newResult) {
+		r.changedLocked(oldResult, newResult)
+	}
 }
 
 // Returns the health status for the health component
@@ -68,6 +148,9 @@ func (r *healthComponent) Status() health.HealthState_Value {
 	r.RLock()
 	defer r.RUnlock()
 
+	if r.expiredLocked() {
+		return r.expiredResultLocked().State.Value()
+	}
 	return r.state.Value()
 }
 
@@ -76,21 +159,156 @@ func (r *healthComponent) GetHealthCheck() health.HealthCheckResult {
 	r.Lock()
 	defer r.Unlock()
 
-	var message *string
-	params := make(map[string]interface{}, len(r.params))
+	r.reportExpiryLocked()
+	return r.healthCheckLocked()
+}
 
-	if r.message != nil {
-		messageCopy := *r.message
-		message = &messageCopy
+// reportExpiry reports the expiry of the current state as a change if it expired and was not reported yet.
+func (r *healthComponent) reportExpiry() {
+	r.Lock()
+	defer r.Unlock()
+	r.reportExpiryLocked()
+}
+
+// reportExpiryLocked is reportExpiry for callers that hold the lock. The expiry is recorded in the history as a
+// transition at the time the state expired.
+func (r *healthComponent) reportExpiryLocked() {
+	if r.expiredLocked() && !r.expiryReported {
+		r.expiryReported = true
+		oldResult := r.storedHealthCheckLocked()
+		r.history.addIfChanged(HealthTransition{
+			Time:    r.staleSinceLocked(),
+			State:   health.HealthState_Value(r.expiredStateLocked().String()),
+			Message: r.expiredMessageLocked(),
+		})
+		r.changedLocked(oldResult, r.expiredResultLocked())
+	}
+}
+
+func (r *healthComponent) AddHook(hook HealthHook) func() {
+	return r.hooks.add(hook)
+}
+
+// changedLocked reports that the health check result of the component changed. The caller must hold the lock.
+func (r *healthComponent) changedLocked(oldResult, newResult health.HealthCheckResult) {
+	if r.onChange != nil {
+		r.onChange()
+	}
+	r.dispatchLocked(HealthComponentChanged, &oldResult, &newResult)
+}
+
+// dispatchLocked delivers an event of the component to the hooks of the component and its reporters. The caller must
+// hold the lock so that events are dispatched in order.
+func (r *healthComponent) dispatchLocked(eventType HealthEventType, oldResult, newResult *health.HealthCheckResult) {
+	r.dispatcher.dispatch(HealthEvent{
+		Type:      eventType,
+		CheckType: r.name,
+		Time:      time.Now(),
+		Old:       oldResult,
+		New:       newResult,
+	}, r.hookSets...)
+}
+
+// registered reports that the component was initialized.
+func (r *healthComponent) registered() {
+	r.Lock()
+	defer r.Unlock()
+	result := r.healthCheckLocked()
+	r.dispatchLocked(HealthComponentRegistered, nil, &result)
+}
+
+// unregistered reports that the component was unregistered.
+func (r *healthComponent) unregistered() {
+	r.Lock()
+	defer r.Unlock()
+	r.reportExpiryLocked()
+	result := r.healthCheckLocked()
+	r.dispatchLocked(HealthComponentUnregistered, &result, nil)
+}
+
+func (r *healthComponent) History() []HealthTransition {
+	r.Lock()
+	defer r.Unlock()
+
+	r.reportExpiryLocked()
+	history := r.history.list()
+	for i := range history {
+		history[i].Message = copyStringPtr(history[i].Message)
+	}
+	return history
+}
+
+// healthCheckLocked returns a copy of the current health check result. The caller must hold the lock.
+func (r *healthComponent) healthCheckLocked() health.HealthCheckResult {
+	if r.expiredLocked() {
+		return r.expiredResultLocked()
 	}
+	return r.storedHealthCheckLocked()
+}
+
+// storedHealthCheckLocked returns a copy of the health check result as it was last set, ignoring expiry. The caller
+// must hold the lock.
+func (r *healthComponent) storedHealthCheckLocked() health.HealthCheckResult {
+	params := make(map[string]interface{}, len(r.params))
 	for key, value := range r.params {
 		params[key] = value
 	}
+	if r.historySummary {
+		if summary := r.history.summary(); summary != "" {
+			params[HistorySummaryParam] = summary
+		}
+	}
 
 	return health.HealthCheckResult{
 		Type:    r.name,
 		State:   r.state,
-		Message: message,
+		Message: copyStringPtr(r.message),
 		Params:  params,
 	}
 }
+
+// expiredLocked returns whether the state has not been set within its TTL. The caller must hold the lock.
+func (r *healthComponent) expiredLocked() bool {
+	return r.ttl > 0 && !r.updated.IsZero() && time.Since(r.updated) > r.ttl
+}
+
+// expiredResultLocked returns the health check result of an expired component: the worse of the last state and the
+// expired state, with a message explaining the expiry and the StaleSinceParam param. The caller must hold the lock.
+func (r *healthComponent) expiredResultLocked() health.HealthCheckResult {
+	result := r.storedHealthCheckLocked()
+	result.State = r.expiredStateLocked()
+	result.Message = r.expiredMessageLocked()
+	result.Params[StaleSinceParam] = r.staleSinceLocked().UTC().Format(time.RFC3339Nano)
+	return result
+}
+
+// expiredStateLocked returns the worse of the last state and the expired state. The caller must hold the lock.
+func (r *healthComponent) expiredStateLocked() health.HealthState {
+	expiredState := health.New_HealthState(r.expiredState)
+	if status.CompareHealthStates(expiredState, r.state) > 0 {
+		return expiredState
+	}
+	return r.state
+}
+
+// expiredMessageLocked returns the message of an expired component. The caller must hold the lock.
+func (r *healthComponent) expiredMessageLocked() *string {
+	message := "Health state was not refreshed within " + r.ttl.String()
+	if r.message != nil {
+		message += ": " + *r.message
+	}
+	return &message
+}
+
+// staleSinceLocked returns the time at which the state expired. The caller must hold the lock.
+func (r *healthComponent) staleSinceLocked() time.Time {
+	return r.updated.Add(r.ttl)
+}
+
+func copyStringPtr(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	sCopy := *s
+	return &sCopy
+}
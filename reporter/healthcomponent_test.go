@@ -18,8 +18,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/healthlog"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -60,10 +65,11 @@ func TestErrorSetting(t *testing.T) {
 	component, healthReporter := setup(t)
 	component.Error(errors.New("err"))
 	assert.Equal(t, health.HealthState_ERROR, component.Status())
-	status := healthReporter.HealthStatus(context.TODO())
-	componentStatus, found := status.Checks[validComponent]
+	healthStatus := healthReporter.HealthStatus(context.TODO())
+	componentStatus, found := healthStatus.Checks[validComponent]
 	assert.True(t, found)
-	assert.Equal(t, "err", *componentStatus.Message)
+	assert.Equal(t, unsafeErrorMessage, *componentStatus.Message)
+	assert.Equal(t, status.NewUnsafeParam("err"), componentStatus.Params[ErrorParam])
 }
 
 func TestSetHealthAndGetHealthResult(t *testing.T) {
@@ -103,3 +109,62 @@ func TestGetHealthCheckCopy(t *testing.T) {
 	assert.NotEqual(t, component.(*healthComponent).message, componentResult.Message)
 	assert.NotEqual(t, component.(*healthComponent).params, componentResult.Params)
 }
+
+type messageLogger struct {
+	messages []string
+}
+
+func (m *messageLogger) Debug(msg string, _ ...svc1log.Param) { m.messages = append(m.messages, msg) }
+func (m *messageLogger) Info(msg string, _ ...svc1log.Param)  { m.messages = append(m.messages, msg) }
+func (m *messageLogger) Warn(msg string, _ ...svc1log.Param)  { m.messages = append(m.messages, msg) }
+func (m *messageLogger) Error(msg string, _ ...svc1log.Param) { m.messages = append(m.messages, msg) }
+func (m *messageLogger) SetLevel(wlog.LogLevel)               {}
+
+func TestSetHealthLogsTransitions(t *testing.T) {
+	logger := &messageLogger{}
+	healthReporter := NewHealthReporter(WithTransitionLogger(healthlog.NewTransitionLogger(logger, healthlog.WithMinLogInterval(0))))
+	component, err := healthReporter.InitializeHealthComponent(validComponent)
+	assert.NoError(t, err)
+
+	component.Healthy()
+	component.Healthy()
+	component.Error(errors.New("err"))
+	assert.Equal(t, []string{"Health check state changed.", "Health check state degraded."}, logger.messages)
+}
+
+func TestHealthStateTTL(t *testing.T) {
+	healthReporter := NewHealthReporter()
+	component, err := healthReporter.InitializeHealthComponent(validComponent, WithHealthStateTTL(20*time.Millisecond, health.HealthState_ERROR))
+	assert.NoError(t, err)
+
+	component.Warning("slow")
+	assert.Equal(t, health.HealthState_WARNING, component.Status())
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, health.HealthState_ERROR, component.Status())
+	result := healthReporter.HealthStatus(context.TODO()).Checks[validComponent]
+	assert.Equal(t, health.HealthState_ERROR, result.State.Value())
+	assert.Equal(t, "Health state was not refreshed within 20ms: slow", *result.Message)
+	assert.Contains(t, result.Params, StaleSinceParam)
+
+	component.Healthy()
+	assert.Equal(t, health.HealthState_HEALTHY, component.Status())
+	assert.NotContains(t, component.GetHealthCheck().Params, StaleSinceParam)
+
+	component.SetHealthWithTTL(health.HealthState_HEALTHY, nil, nil, 0)
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, health.HealthState_HEALTHY, component.Status())
+}
+
+func TestSetHealthWithTTL(t *testing.T) {
+	component, _ := setup(t)
+	assert.Equal(t, health.HealthState_REPAIRING, component.Status())
+
+	component.SetHealthWithTTL(health.HealthState_HEALTHY, nil, nil, 20*time.Millisecond)
+	assert.Equal(t, health.HealthState_HEALTHY, component.Status())
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, health.HealthState_REPAIRING, component.Status())
+
+	component.SetHealthWithTTL(health.HealthState_TERMINAL, nil, nil, 20*time.Millisecond)
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, health.HealthState_TERMINAL, component.Status())
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/sources"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+const (
+	// ErrorCausesParam is the param of a result set using Error or ErrorWithParams that holds the messages of the
+	// chain of causes of the error from outermost to innermost. It is only set if the error has a cause, and is an
+	// unsafe param because error messages may contain sensitive information.
+	ErrorCausesParam = "errorCauses"
+	// ErrorStackParam is the param of a result set using Error or ErrorWithParams on a health component configured
+	// with WithErrorStack that holds the innermost frames of the stack at which the error was created.
+	ErrorStackParam = "errorStack"
+	// ErrorParam is the unsafe param of a result set using Error or ErrorWithParams with an error that is not a
+	// werror, which holds the message of the error since it cannot be used as the message of the result.
+	ErrorParam = "error"
+
+	// unsafeErrorMessage is the message of a result set using Error or ErrorWithParams with an error that is not a
+	// werror, whose message may contain sensitive information.
+	unsafeErrorMessage = "Health check failed with an error"
+)
+
+// errorParams returns the safe and unsafe params of err along with its chain of causes and, if stackFrames is
+// positive, up to stackFrames frames of its stack, overridden by params.
+func errorParams(err error, params map[string]interface{}, stackFrames int) map[string]interface{} {
+	errParams := sources.ParamsFromError(err)
+	if _, ok := werrorMessage(err); !ok {
+		errParams[ErrorParam] = status.NewUnsafeParam(err.Error())
+	}
+	if causes := errorCauses(err); len(causes) > 1 {
+		errParams[ErrorCausesParam] = status.NewUnsafeParam(causes)
+	}
+	if stackFrames > 0 {
+		if stack := errorStack(err, stackFrames); len(stack) > 0 {
+			errParams[ErrorStackParam] = stack
+		}
+	}
+	for key, value := range params {
+		errParams[key] = value
+	}
+	if len(errParams) == 0 {
+		return nil
+	}
+	return errParams
+}
+
+// errorMessage returns the message of err without the messages of its causes if err is a werror, whose message is
+// expected to be safe. The messages of other errors may contain sensitive information, so a fixed message is returned
+// for them and their message is only reported in the unsafe ErrorParam param.
+func errorMessage(err error) string {
+	if message, ok := werrorMessage(err); ok {
+		return message
+	}
+	return unsafeErrorMessage
+}
+
+// werrorMessage returns the message of err without the messages of its causes if err is a werror with a message.
+func werrorMessage(err error) (string, bool) {
+	werr, ok := err.(werror.Werror)
+	if !ok || werr.Message() == "" {
+		return "", false
+	}
+	return werr.Message(), true
+}
+
+// errorCauses returns the messages of err and its causes, without the messages of their own causes.
+func errorCauses(err error) []string {
+	var causes []string
+	for err != nil {
+		cause := unwrapError(err)
+		message := err.Error()
+		if werr, ok := err.(werror.Werror); ok {
+			message = werr.Message()
+		} else if cause != nil {
+			message = strings.TrimSuffix(message, ": "+cause.Error())
+		}
+		if message != "" {
+			causes = append(causes, message)
+		}
+		err = cause
+	}
+	return causes
+}
+
+func unwrapError(err error) error {
+	if causer, ok := err.(werror.Causer); ok {
+		return causer.Cause()
+	}
+	return errors.Unwrap(err)
+}
+
+// errorStack returns up to maxFrames frames of the stack of the innermost cause of err that has one.
+func errorStack(err error, maxFrames int) []string {
+	var stackTrace werror.StackTrace
+	for ; err != nil; err = unwrapError(err) {
+		if tracer, ok := err.(werror.StackTracer); ok && tracer.StackTrace() != nil {
+			stackTrace = tracer.StackTrace()
+		}
+	}
+	if stackTrace == nil {
+		return nil
+	}
+	// each frame is formatted as "\n<function>\n\t<file>:<line>"
+	lines := strings.Split(strings.TrimPrefix(fmt.Sprintf("%+v", stackTrace), "\n"), "\n")
+	var frames []string
+	for i := 0; i+1 < len(lines) && len(frames) < maxFrames; i += 2 {
+		frames = append(frames, lines[i]+" ("+strings.TrimSpace(lines[i+1])+")")
+	}
+	return frames
+}
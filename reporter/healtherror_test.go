@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorParams(t *testing.T) {
+	component, _ := setup(t)
+	err := werror.Wrap(
+		werror.Wrap(fmt.Errorf("connection refused: %w", io.EOF), "query failed", werror.SafeParam("host", "db")),
+		"failed to load cache",
+		werror.SafeParam("cache", "users"),
+		werror.UnsafeParam("userId", "42"))
+	component.Error(err)
+
+	result := component.GetHealthCheck()
+	assert.Equal(t, health.HealthState_ERROR, result.State.Value())
+	assert.Equal(t, "failed to load cache", *result.Message)
+	assert.Equal(t, map[string]interface{}{
+		"host":           "db",
+		"cache":          "users",
+		"userId":         status.NewUnsafeParam("42"),
+		ErrorCausesParam: status.NewUnsafeParam([]string{"failed to load cache", "query failed", "connection refused", "EOF"}),
+	}, result.Params)
+}
+
+func TestErrorParamsWithoutCauses(t *testing.T) {
+	component, _ := setup(t)
+	component.Error(werror.Error("werror"))
+	assert.Equal(t, "werror", *component.GetHealthCheck().Message)
+	assert.Empty(t, component.GetHealthCheck().Params)
+
+	component.Error(fmt.Errorf("plain error"))
+	assert.Equal(t, unsafeErrorMessage, *component.GetHealthCheck().Message)
+	assert.Equal(t, map[string]interface{}{
+		ErrorParam: status.NewUnsafeParam("plain error"),
+	}, component.GetHealthCheck().Params)
+}
+
+func TestErrorWithParams(t *testing.T) {
+	component, _ := setup(t)
+	component.ErrorWithParams(werror.Error("failed", werror.SafeParam("attempt", 1)), map[string]interface{}{
+		"attempt": 2,
+		"region":  "us-east",
+	})
+	assert.Equal(t, map[string]interface{}{"attempt": 2, "region": "us-east"}, component.GetHealthCheck().Params)
+}
+
+func TestWarningWithParams(t *testing.T) {
+	component, _ := setup(t)
+	component.WarningWithParams("slow", map[string]interface{}{"latency": "3s"})
+	result := component.GetHealthCheck()
+	assert.Equal(t, health.HealthState_WARNING, result.State.Value())
+	assert.Equal(t, "slow", *result.Message)
+	assert.Equal(t, map[string]interface{}{"latency": "3s"}, result.Params)
+}
+
+func TestErrorStack(t *testing.T) {
+	component, err := NewHealthReporter().InitializeHealthComponent(validComponent, WithErrorStack(2))
+	require.NoError(t, err)
+	component.Error(werror.Wrap(werror.Error("inner"), "outer"))
+
+	stack, ok := component.GetHealthCheck().Params[ErrorStackParam].([]string)
+	require.True(t, ok)
+	require.Len(t, stack, 2)
+	assert.True(t, strings.HasPrefix(stack[0], "github.com/palantir/witchcraft-go-health/reporter.TestErrorStack ("), stack[0])
+	assert.Contains(t, stack[0], "healtherror_test.go:")
+
+	component.Error(fmt.Errorf("no stack"))
+	assert.NotContains(t, component.GetHealthCheck().Params, ErrorStackParam)
+}
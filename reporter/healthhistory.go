@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// HistorySummaryParam is the param that holds the summary of the recent transitions of a health component configured
+// with WithHistorySummary.
+const HistorySummaryParam = "stateHistory"
+
+const defaultHistorySize = 16
+
+// HealthTransition is a change of the state of a health component.
+type HealthTransition struct {
+	// Time is when the component entered State.
+	Time    time.Time
+	State   health.HealthState_Value
+	Message *string
+}
+
+// transitionHistory is a ring buffer of the most recent transitions of a health component.
+type transitionHistory struct {
+	transitions []HealthTransition
+	// next is the index at which the next transition is stored.
+	next int
+	full bool
+}
+
+func newTransitionHistory(size int) *transitionHistory {
+	return &transitionHistory{
+		transitions: make([]HealthTransition, size),
+	}
+}
+
+func (h *transitionHistory) add(transition HealthTransition) {
+	if len(h.transitions) == 0 {
+		return
+	}
+	h.transitions[h.next] = transition
+	h.next = (h.next + 1) % len(h.transitions)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// addIfChanged adds transition unless its state is the state of the newest transition.
+func (h *transitionHistory) addIfChanged(transition HealthTransition) {
+	if current, _ := h.latest(); current != nil && current.State == transition.State {
+		return
+	}
+	h.add(transition)
+}
+
+// list returns the transitions from oldest to newest.
+func (h *transitionHistory) list() []HealthTransition {
+	if !h.full {
+		return append([]HealthTransition(nil), h.transitions[:h.next]...)
+	}
+	list := make([]HealthTransition, 0, len(h.transitions))
+	list = append(list, h.transitions[h.next:]...)
+	return append(list, h.transitions[:h.next]...)
+}
+
+// latest returns the newest transition and the one before it, if any.
+func (h *transitionHistory) latest() (current, previous *HealthTransition) {
+	size := len(h.transitions)
+	count := h.next
+	if h.full {
+		count = size
+	}
+	if count > 0 {
+		current = &h.transitions[(h.next-1+size)%size]
+	}
+	if count > 1 {
+		previous = &h.transitions[(h.next-2+size)%size]
+	}
+	return current, previous
+}
+
+// summary describes the newest transition and the one before it, for example
+// "ERROR since 2026-01-02T15:04:05Z, previously HEALTHY for 3h0m0s".
+func (h *transitionHistory) summary() string {
+	current, previous := h.latest()
+	if current == nil {
+		return ""
+	}
+	summary := fmt.Sprintf("%s since %s", current.State, current.Time.UTC().Format(time.RFC3339))
+	if previous != nil {
+		summary += fmt.Sprintf(", previously %s for %s", previous.State, roundDuration(current.Time.Sub(previous.Time)))
+	}
+	return summary
+}
+
+func roundDuration(d time.Duration) time.Duration {
+	if d < time.Second {
+		return d.Round(time.Millisecond)
+	}
+	return d.Round(time.Second)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"testing"
+	"time"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionHistoryRingBuffer(t *testing.T) {
+	history := newTransitionHistory(3)
+	assert.Empty(t, history.list())
+	assert.Empty(t, history.summary())
+
+	start := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	for i, state := range []health.HealthState_Value{
+		health.HealthState_REPAIRING,
+		health.HealthState_HEALTHY,
+		health.HealthState_WARNING,
+		health.HealthState_ERROR,
+	} {
+		history.add(HealthTransition{Time: start.Add(time.Duration(i) * time.Hour), State: state})
+	}
+	var states []health.HealthState_Value
+	for _, transition := range history.list() {
+		states = append(states, transition.State)
+	}
+	assert.Equal(t, []health.HealthState_Value{health.HealthState_HEALTHY, health.HealthState_WARNING, health.HealthState_ERROR}, states)
+	assert.Equal(t, "ERROR since 2026-01-02T15:00:00Z, previously WARNING for 1h0m0s", history.summary())
+
+	disabled := newTransitionHistory(0)
+	disabled.add(HealthTransition{Time: start, State: health.HealthState_HEALTHY})
+	assert.Empty(t, disabled.list())
+	assert.Empty(t, disabled.summary())
+}
+
+func TestHealthComponentHistory(t *testing.T) {
+	healthReporter := NewHealthReporter()
+	component, err := healthReporter.InitializeHealthComponent(validComponent, WithHistorySize(3), WithHistorySummary())
+	require.NoError(t, err)
+
+	component.Healthy()
+	component.Healthy()
+	component.Warning("slow")
+	component.Error(werror.Error("broken"))
+
+	history := component.History()
+	require.Len(t, history, 3)
+	assert.Equal(t, health.HealthState_HEALTHY, history[0].State)
+	assert.Nil(t, history[0].Message)
+	assert.Equal(t, health.HealthState_WARNING, history[1].State)
+	assert.Equal(t, "slow", *history[1].Message)
+	assert.Equal(t, health.HealthState_ERROR, history[2].State)
+	assert.Equal(t, "broken", *history[2].Message)
+	assert.False(t, history[2].Time.Before(history[1].Time))
+
+	summary, ok := component.GetHealthCheck().Params[HistorySummaryParam].(string)
+	require.True(t, ok)
+	assert.Contains(t, summary, "ERROR since ")
+	assert.Contains(t, summary, ", previously WARNING for ")
+
+	other, err := healthReporter.InitializeHealthComponent("OTHER_COMPONENT")
+	require.NoError(t, err)
+	assert.Len(t, other.History(), 1)
+	assert.NotContains(t, other.GetHealthCheck().Params, HistorySummaryParam)
+}
+
+func TestHealthComponentHistoryOnExpiry(t *testing.T) {
+	healthReporter := NewHealthReporter()
+	component, err := healthReporter.InitializeHealthComponent(validComponent,
+		WithHistorySummary(),
+		WithHealthStateTTL(10*time.Millisecond, health.HealthState_REPAIRING))
+	require.NoError(t, err)
+
+	component.Healthy()
+	time.Sleep(20 * time.Millisecond)
+
+	result := component.GetHealthCheck()
+	assert.Equal(t, health.HealthState_REPAIRING, result.State.Value())
+	staleSince, err := time.Parse(time.RFC3339Nano, result.Params[StaleSinceParam].(string))
+	require.NoError(t, err)
+	summary, ok := result.Params[HistorySummaryParam].(string)
+	require.True(t, ok)
+	assert.Contains(t, summary, "REPAIRING since "+staleSince.UTC().Format(time.RFC3339))
+	assert.Contains(t, summary, ", previously HEALTHY for ")
+
+	history := component.History()
+	require.Len(t, history, 3)
+	assert.Equal(t, health.HealthState_HEALTHY, history[1].State)
+	assert.Equal(t, health.HealthState_REPAIRING, history[2].State)
+	assert.True(t, staleSince.Equal(history[2].Time))
+	assert.Equal(t, "Health state was not refreshed within 10ms", *history[2].Message)
+
+	component.Healthy()
+	history = component.History()
+	require.Len(t, history, 4)
+	assert.Equal(t, health.HealthState_HEALTHY, history[3].State)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+)
+
+// HealthEventType is the type of a HealthEvent.
+type HealthEventType string
+
+const (
+	// HealthComponentRegistered is the type of events for health components that were initialized.
+	HealthComponentRegistered HealthEventType = "REGISTERED"
+	// HealthComponentUnregistered is the type of events for health components that were unregistered.
+	HealthComponentUnregistered HealthEventType = "UNREGISTERED"
+	// HealthComponentChanged is the type of events for health components whose health check result changed.
+	HealthComponentChanged HealthEventType = "CHANGED"
+)
+
+// HealthEvent describes a change of a health component.
+type HealthEvent struct {
+	Type      HealthEventType
+	CheckType health.CheckType
+	Time      time.Time
+	// Old is the result before the event; nil for HealthComponentRegistered events.
+	Old *health.HealthCheckResult
+	// New is the result after the event; nil for HealthComponentUnregistered events.
+	New *health.HealthCheckResult
+}
+
+// HealthHook is called with the events of the health components it was added to. Hooks are called asynchronously in
+// a separate goroutine so that slow hooks do not block SetHealth; the events of a health component are delivered to
+// its hooks in the order in which they occurred.
+type HealthHook func(event HealthEvent)
+
+// healthHooks is a set of hooks that can be read without copying.
+type healthHooks struct {
+	mutex sync.RWMutex
+	hooks []*HealthHook
+}
+
+// add adds hook and returns a function that removes it.
+func (h *healthHooks) add(hook HealthHook) func() {
+	entry := &hook
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.hooks = append(append([]*HealthHook(nil), h.hooks...), entry)
+	return func() {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		hooks := make([]*HealthHook, 0, len(h.hooks))
+		for _, existing := range h.hooks {
+			if existing != entry {
+				hooks = append(hooks, existing)
+			}
+		}
+		h.hooks = hooks
+	}
+}
+
+// list returns the current hooks. The returned slice must not be modified.
+func (h *healthHooks) list() []*HealthHook {
+	if h == nil {
+		return nil
+	}
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return h.hooks
+}
+
+type hookDelivery struct {
+	hooks []*HealthHook
+	event HealthEvent
+}
+
+// hookDispatcher delivers events to hooks in order using a goroutine that runs while deliveries are pending.
+type hookDispatcher struct {
+	// mutex protects access to `pending` and `running`.
+	mutex   sync.Mutex
+	pending []hookDelivery
+	running bool
+}
+
+func (d *hookDispatcher) dispatch(event HealthEvent, hookSets ...*healthHooks) {
+	var hooks []*HealthHook
+	for _, hookSet := range hookSets {
+		hooks = append(hooks, hookSet.list()...)
+	}
+	if d == nil || len(hooks) == 0 {
+		return
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.pending = append(d.pending, hookDelivery{hooks: hooks, event: event})
+	if !d.running {
+		d.running = true
+		go d.run()
+	}
+}
+
+func (d *hookDispatcher) run() {
+	for {
+		d.mutex.Lock()
+		if len(d.pending) == 0 {
+			d.running = false
+			d.mutex.Unlock()
+			return
+		}
+		delivery := d.pending[0]
+		d.pending[0] = hookDelivery{}
+		d.pending = d.pending[1:]
+		d.mutex.Unlock()
+
+		for _, hook := range delivery.hooks {
+			hook := *hook
+			wapp.RunWithRecoveryLogging(context.Background(), func(ctx context.Context) {
+				hook(delivery.event)
+			})
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveEvent(t *testing.T, events <-chan HealthEvent) HealthEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for health event")
+		return HealthEvent{}
+	}
+}
+
+func TestHealthReporterHooks(t *testing.T) {
+	reporter := NewHealthReporter()
+	events := make(chan HealthEvent, 10)
+	remove := reporter.AddHook(func(event HealthEvent) {
+		events <- event
+	})
+
+	component, err := reporter.InitializeHealthComponent("COMPONENT")
+	require.NoError(t, err)
+	event := receiveEvent(t, events)
+	assert.Equal(t, HealthComponentRegistered, event.Type)
+	assert.Equal(t, health.CheckType("COMPONENT"), event.CheckType)
+	assert.Nil(t, event.Old)
+	require.NotNil(t, event.New)
+	assert.Equal(t, health.HealthState_REPAIRING, event.New.State.Value())
+
+	component.Healthy()
+	event = receiveEvent(t, events)
+	assert.Equal(t, HealthComponentChanged, event.Type)
+	assert.Equal(t, health.HealthState_REPAIRING, event.Old.State.Value())
+	assert.Equal(t, health.HealthState_HEALTHY, event.New.State.Value())
+
+	// unchanged results do not produce events
+	component.Healthy()
+	component.Error(assert.AnError)
+	event = receiveEvent(t, events)
+	assert.Equal(t, HealthComponentChanged, event.Type)
+	assert.Equal(t, health.HealthState_HEALTHY, event.Old.State.Value())
+	assert.Equal(t, health.HealthState_ERROR, event.New.State.Value())
+
+	assert.True(t, reporter.UnregisterHealthComponent("COMPONENT"))
+	event = receiveEvent(t, events)
+	assert.Equal(t, HealthComponentUnregistered, event.Type)
+	assert.Equal(t, health.HealthState_ERROR, event.Old.State.Value())
+	assert.Nil(t, event.New)
+
+	remove()
+	_, err = reporter.InitializeHealthComponent("OTHER")
+	require.NoError(t, err)
+	select {
+	case event := <-events:
+		assert.Fail(t, "unexpected event after removing hook", "%v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHealthComponentHooksAreAsynchronousAndOrdered(t *testing.T) {
+	reporter := NewHealthReporter()
+	component, err := reporter.InitializeHealthComponent("COMPONENT")
+	require.NoError(t, err)
+
+	unblock := make(chan struct{})
+	var states []health.HealthState_Value
+	done := make(chan struct{})
+	component.AddHook(func(event HealthEvent) {
+		<-unblock
+		states = append(states, event.New.State.Value())
+		if len(states) == 3 {
+			close(done)
+		}
+	})
+	// a panicking hook does not prevent the delivery to other hooks
+	component.AddHook(func(event HealthEvent) {
+		panic("hook failed")
+	})
+
+	setHealth := make(chan struct{})
+	go func() {
+		component.Healthy()
+		component.Warning("warning")
+		component.Error(assert.AnError)
+		close(setHealth)
+	}()
+	select {
+	case <-setHealth:
+	case <-time.After(time.Second):
+		require.FailNow(t, "setting health was blocked by a hook")
+	}
+
+	close(unblock)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for hooks")
+	}
+	assert.Equal(t, []health.HealthState_Value{
+		health.HealthState_HEALTHY,
+		health.HealthState_WARNING,
+		health.HealthState_ERROR,
+	}, states)
+}
+
+func TestScopedHealthReporterHooks(t *testing.T) {
+	parent := NewHealthReporter()
+	scoped := MustNewScopedHealthReporter(parent, "BILLING")
+	events := make(chan HealthEvent, 10)
+	scoped.AddHook(func(event HealthEvent) {
+		events <- event
+	})
+
+	_, err := parent.InitializeHealthComponent("DATABASE")
+	require.NoError(t, err)
+	_, err = scoped.InitializeHealthComponent("DATABASE")
+	require.NoError(t, err)
+	event := receiveEvent(t, events)
+	assert.Equal(t, HealthComponentRegistered, event.Type)
+	assert.Equal(t, health.CheckType("BILLING_DATABASE"), event.CheckType)
+
+	assert.Equal(t, 1, scoped.UnregisterScope())
+	event = receiveEvent(t, events)
+	assert.Equal(t, HealthComponentUnregistered, event.Type)
+	assert.Equal(t, health.CheckType("BILLING_DATABASE"), event.CheckType)
+}
+
+func TestScopedHealthReporterHooksWithPrefixedScope(t *testing.T) {
+	parent := NewHealthReporter()
+	billing := MustNewScopedHealthReporter(parent, "BILLING")
+	billingEU := MustNewScopedHealthReporter(parent, "BILLING_EU")
+	billingEvents := make(chan HealthEvent, 10)
+	billing.AddHook(func(event HealthEvent) {
+		billingEvents <- event
+	})
+	billingEUEvents := make(chan HealthEvent, 10)
+	billingEU.AddHook(func(event HealthEvent) {
+		billingEUEvents <- event
+	})
+
+	euComponent, err := billingEU.InitializeHealthComponent("DATABASE")
+	require.NoError(t, err)
+	other, err := parent.InitializeHealthComponent("BILLING_OTHER")
+	require.NoError(t, err)
+	component, err := billing.InitializeHealthComponent("DATABASE")
+	require.NoError(t, err)
+	euComponent.Healthy()
+	other.Healthy()
+	component.Healthy()
+	assert.True(t, billingEU.UnregisterHealthComponent("DATABASE"))
+	assert.True(t, parent.UnregisterHealthComponent("BILLING_OTHER"))
+	assert.Equal(t, 1, billing.UnregisterScope())
+
+	for _, expected := range []HealthEventType{HealthComponentRegistered, HealthComponentChanged, HealthComponentUnregistered} {
+		event := receiveEvent(t, billingEvents)
+		assert.Equal(t, expected, event.Type)
+		assert.Equal(t, health.CheckType("BILLING_DATABASE"), event.CheckType)
+
+		event = receiveEvent(t, billingEUEvents)
+		assert.Equal(t, expected, event.Type)
+		assert.Equal(t, health.CheckType("BILLING_EU_DATABASE"), event.CheckType)
+	}
+	select {
+	case event := <-billingEvents:
+		assert.Fail(t, "unexpected event", "%+v", event)
+	case event := <-billingEUEvents:
+		assert.Fail(t, "unexpected event", "%+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+)
+
+// ReadinessPolicy returns whether a service with the provided health status is ready and, if not, the reason why.
+type ReadinessPolicy func(healthStatus health.HealthStatus) (ready bool, reason string)
+
+// NotReadyIfAnyCheckInState returns a ReadinessPolicy that considers the service not ready while any of the provided
+// check types is in one of the provided states. If no check types are provided, all checks are considered.
+func NotReadyIfAnyCheckInState(checkTypes []health.CheckType, states ...health.HealthState_Value) ReadinessPolicy {
+	return func(healthStatus health.HealthStatus) (bool, string) {
+		var blocking []string
+		for checkType, result := range healthStatus.Checks {
+			if len(checkTypes) > 0 && !containsCheckType(checkTypes, checkType) {
+				continue
+			}
+			for _, state := range states {
+				if result.State.Value() == state {
+					blocking = append(blocking, fmt.Sprintf("%s is %s", checkType, state))
+					break
+				}
+			}
+		}
+		if len(blocking) == 0 {
+			return true, ""
+		}
+		sort.Strings(blocking)
+		return false, strings.Join(blocking, ", ")
+	}
+}
+
+func containsCheckType(checkTypes []health.CheckType, checkType health.CheckType) bool {
+	for _, candidate := range checkTypes {
+		if candidate == checkType {
+			return true
+		}
+	}
+	return false
+}
+
+type HealthReadinessOption func(conf *healthReadinessConfig)
+
+type healthReadinessConfig struct {
+	failureThreshold int
+	successThreshold int
+}
+
+func defaultHealthReadinessConfig() healthReadinessConfig {
+	return healthReadinessConfig{
+		failureThreshold: 1,
+		successThreshold: 1,
+	}
+}
+
+func (h *healthReadinessConfig) apply(options ...HealthReadinessOption) {
+	for _, option := range options {
+		option(h)
+	}
+}
+
+// WithHysteresis - requires failureThreshold consecutive not ready evaluations before a ready component becomes not
+// ready and successThreshold consecutive ready evaluations before a not ready component becomes ready.
+func WithHysteresis(failureThreshold, successThreshold int) HealthReadinessOption {
+	return func(conf *healthReadinessConfig) {
+		conf.failureThreshold = failureThreshold
+		conf.successThreshold = successThreshold
+	}
+}
+
+type healthDrivenReadiness struct {
+	component Component
+	source    status.HealthCheckSource
+	policy    ReadinessPolicy
+	interval  time.Duration
+	config    healthReadinessConfig
+
+	// only accessed by the polling goroutine
+	ready       bool
+	consecutive int
+}
+
+// MustInitializeHealthDrivenReadinessComponent is a convenience function that calls
+// InitializeHealthDrivenReadinessComponent and panics if it returns an error.
+func MustInitializeHealthDrivenReadinessComponent(ctx context.Context, reporter Reporter, name ComponentName, source status.HealthCheckSource, policy ReadinessPolicy, interval time.Duration, options ...HealthReadinessOption) Component {
+	component, err := InitializeHealthDrivenReadinessComponent(ctx, reporter, name, source, policy, interval, options...)
+	if err != nil {
+		panic(err)
+	}
+	return component
+}
+
+// InitializeHealthDrivenReadinessComponent - registers a readiness component in reporter whose readiness is derived
+// from the health status of source. The source is evaluated against policy immediately and then every interval until
+// ctx is done, after which the component is not ready. The component is not ready until the policy first considers the
+// service ready, and an evaluation in which source or policy panics counts as not ready.
+func InitializeHealthDrivenReadinessComponent(ctx context.Context, reporter Reporter, name ComponentName, source status.HealthCheckSource, policy ReadinessPolicy, interval time.Duration, options ...HealthReadinessOption) (Component, error) {
+	if policy == nil {
+		return nil, werror.Error("readiness policy must not be nil")
+	}
+	if interval <= 0 {
+		return nil, werror.Error("interval must be positive",
+			werror.SafeParam("interval", interval.String()))
+	}
+	conf := defaultHealthReadinessConfig()
+	conf.apply(options...)
+	if conf.failureThreshold < 1 || conf.successThreshold < 1 {
+		return nil, werror.Error("hysteresis thresholds must be at least 1",
+			werror.SafeParam("failureThreshold", conf.failureThreshold),
+			werror.SafeParam("successThreshold", conf.successThreshold))
+	}
+	component, err := reporter.InitializeReadinessComponent(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	component.NotReady("Health checks have not been evaluated")
+	readiness := &healthDrivenReadiness{
+		component: component,
+		source:    source,
+		policy:    policy,
+		interval:  interval,
+		config:    conf,
+	}
+	go wapp.RunWithRecoveryLogging(ctx, readiness.runPoll)
+	return component, nil
+}
+
+func (h *healthDrivenReadiness) runPoll(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	h.evaluate(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			h.component.NotReady("Health checks are no longer evaluated")
+			return
+		case <-ticker.C:
+			h.evaluate(ctx)
+		}
+	}
+}
+
+func (h *healthDrivenReadiness) evaluate(ctx context.Context) {
+	ready, reason := h.check(ctx)
+	if ready == h.ready {
+		h.consecutive = 0
+		if !ready {
+			h.component.NotReady(reason)
+		}
+		return
+	}
+	h.consecutive++
+	threshold := h.config.failureThreshold
+	if ready {
+		threshold = h.config.successThreshold
+	}
+	if h.consecutive < threshold {
+		return
+	}
+	h.ready = ready
+	h.consecutive = 0
+	if ready {
+		h.component.Ready()
+	} else {
+		h.component.NotReady(reason)
+	}
+}
+
+// check evaluates the health status of the source against the policy. A panic of the source or the policy is
+// recovered and reported as not ready so that it does not stop the polling goroutine.
+func (h *healthDrivenReadiness) check(ctx context.Context) (ready bool, reason string) {
+	defer func() {
+		if r := recover(); r != nil {
+			ready, reason = false, "Health checks panicked while being evaluated"
+		}
+	}()
+	return h.policy(h.source.HealthStatus(ctx))
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mutableHealthCheckSource struct {
+	mutex        sync.Mutex
+	healthStatus health.HealthStatus
+}
+
+func (m *mutableHealthCheckSource) HealthStatus(_ context.Context) health.HealthStatus {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.healthStatus
+}
+
+func (m *mutableHealthCheckSource) set(states map[health.CheckType]health.HealthState_Value) {
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(states))
+	for checkType, state := range states {
+		checks[checkType] = health.HealthCheckResult{
+			Type:  checkType,
+			State: health.New_HealthState(state),
+		}
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.healthStatus = health.HealthStatus{Checks: checks}
+}
+
+func TestNotReadyIfAnyCheckInState(t *testing.T) {
+	policy := NotReadyIfAnyCheckInState([]health.CheckType{"X", "Y"}, health.HealthState_ERROR, health.HealthState_REPAIRING)
+	source := &mutableHealthCheckSource{}
+
+	source.set(map[health.CheckType]health.HealthState_Value{"X": health.HealthState_WARNING, "Z": health.HealthState_ERROR})
+	ready, reason := policy(source.HealthStatus(context.Background()))
+	assert.True(t, ready)
+	assert.Empty(t, reason)
+
+	source.set(map[health.CheckType]health.HealthState_Value{"X": health.HealthState_REPAIRING, "Y": health.HealthState_ERROR})
+	ready, reason = policy(source.HealthStatus(context.Background()))
+	assert.False(t, ready)
+	assert.Equal(t, "X is REPAIRING, Y is ERROR", reason)
+
+	ready, _ = NotReadyIfAnyCheckInState(nil, health.HealthState_ERROR)(health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"Z": {Type: "Z", State: health.New_HealthState(health.HealthState_ERROR)},
+		},
+	})
+	assert.False(t, ready)
+}
+
+func TestHealthDrivenReadinessHysteresis(t *testing.T) {
+	source := &mutableHealthCheckSource{}
+	component := &readinessComponent{name: "TEST"}
+	readiness := &healthDrivenReadiness{
+		component: component,
+		source:    source,
+		policy:    NotReadyIfAnyCheckInState(nil, health.HealthState_ERROR),
+		config:    healthReadinessConfig{failureThreshold: 2, successThreshold: 3},
+	}
+	healthy := map[health.CheckType]health.HealthState_Value{"X": health.HealthState_HEALTHY}
+	failing := map[health.CheckType]health.HealthState_Value{"X": health.HealthState_ERROR}
+
+	for _, step := range []struct {
+		states         map[health.CheckType]health.HealthState_Value
+		expectedStatus int
+	}{
+		{healthy, 0},
+		{healthy, 0},
+		{healthy, http.StatusOK},
+		{failing, http.StatusOK},
+		{healthy, http.StatusOK},
+		{failing, http.StatusOK},
+		{failing, http.StatusServiceUnavailable},
+		{healthy, http.StatusServiceUnavailable},
+		{failing, http.StatusServiceUnavailable},
+	} {
+		source.set(step.states)
+		readiness.evaluate(context.Background())
+		respStatus, _ := component.Status()
+		assert.Equal(t, step.expectedStatus, respStatus)
+	}
+	_, metadata := component.Status()
+	assert.Equal(t, ReadinessMetadata{State: ReadinessStateNotReady, Reason: "X is ERROR"}, metadata)
+}
+
+func TestInitializeHealthDrivenReadinessComponent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := &mutableHealthCheckSource{}
+	source.set(map[health.CheckType]health.HealthState_Value{"X": health.HealthState_ERROR})
+	reporter := NewReadinessReporter()
+
+	component, err := InitializeHealthDrivenReadinessComponent(ctx, reporter, "HEALTH", source,
+		NotReadyIfAnyCheckInState(nil, health.HealthState_ERROR), 10*time.Millisecond)
+	require.NoError(t, err)
+	respStatus, _ := component.Status()
+	assert.Equal(t, http.StatusServiceUnavailable, respStatus)
+
+	source.set(map[health.CheckType]health.HealthState_Value{"X": health.HealthState_HEALTHY})
+	assert.Eventually(t, func() bool {
+		respStatus, _ := reporter.Status()
+		return respStatus == http.StatusOK
+	}, time.Second, 10*time.Millisecond)
+
+	_, err = InitializeHealthDrivenReadinessComponent(ctx, reporter, "OTHER", source,
+		NotReadyIfAnyCheckInState(nil), 10*time.Millisecond, WithHysteresis(0, 1))
+	assert.Error(t, err)
+	_, err = InitializeHealthDrivenReadinessComponent(ctx, reporter, "HEALTH", source,
+		NotReadyIfAnyCheckInState(nil), 10*time.Millisecond)
+	assert.Error(t, err)
+}
+
+func TestHealthDrivenReadinessPanicAndStop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := &mutableHealthCheckSource{}
+	source.set(map[health.CheckType]health.HealthState_Value{"X": health.HealthState_HEALTHY})
+	var panicking atomic.Bool
+	policy := func(healthStatus health.HealthStatus) (bool, string) {
+		if panicking.Load() {
+			panic("policy failed")
+		}
+		return NotReadyIfAnyCheckInState(nil, health.HealthState_ERROR)(healthStatus)
+	}
+	reporter := NewReadinessReporter()
+
+	component, err := InitializeHealthDrivenReadinessComponent(ctx, reporter, "HEALTH", source, policy, 5*time.Millisecond)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		respStatus, _ := component.Status()
+		return respStatus == http.StatusOK
+	}, time.Second, 5*time.Millisecond)
+
+	panicking.Store(true)
+	assert.Eventually(t, func() bool {
+		_, metadata := component.Status()
+		return assert.ObjectsAreEqual(ReadinessMetadata{State: ReadinessStateNotReady, Reason: "Health checks panicked while being evaluated"}, metadata)
+	}, time.Second, 5*time.Millisecond)
+
+	panicking.Store(false)
+	assert.Eventually(t, func() bool {
+		respStatus, _ := component.Status()
+		return respStatus == http.StatusOK
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		_, metadata := component.Status()
+		return assert.ObjectsAreEqual(ReadinessMetadata{State: ReadinessStateNotReady, Reason: "Health checks are no longer evaluated"}, metadata)
+	}, time.Second, 5*time.Millisecond)
+}
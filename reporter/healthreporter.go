@@ -18,9 +18,12 @@ import (
 	"context"
 	"regexp"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	werror "github.com/palantir/witchcraft-go-error"
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/healthlog"
 	"github.com/palantir/witchcraft-go-health/status"
 )
 
@@ -28,34 +31,132 @@ const slsHealthNameRegex = "^[A-Z_]+$"
 
 var _ HealthReporter = &healthReporter{}
 
+// HealthReporter is a status.SnapshotHealthCheckSource whose generation increases whenever a health component is
+// initialized or unregistered, or the health check result of a health component changes.
 type HealthReporter interface {
-	status.HealthCheckSource
-	InitializeHealthComponent(name string) (HealthComponent, error)
+	status.SnapshotHealthCheckSource
+	InitializeHealthComponent(name string, options ...HealthComponentOption) (HealthComponent, error)
 	GetHealthComponent(name string) (HealthComponent, bool)
 	UnregisterHealthComponent(name string) bool
+	// AddHook adds a hook that is called with the events of every health component of the reporter, including the
+	// health components initialized after the hook was added, and returns a function that removes the hook.
+	AddHook(hook HealthHook) (remove func())
 }
 
 type healthReporter struct {
-	mutex            sync.RWMutex
-	healthComponents map[health.CheckType]HealthComponent
+	// mutex serializes modifications of `healthComponents`.
+	mutex sync.Mutex
+	// healthComponents is replaced rather than modified so that it can be read without holding the mutex.
+	healthComponents atomic.Pointer[map[health.CheckType]HealthComponent]
+	generation       atomic.Uint64
+
+	transitionLogger *healthlog.TransitionLogger
+	hooks            healthHooks
+}
+
+type HealthReporterOption func(reporter *healthReporter)
+
+// WithTransitionLogger - logs the state transitions of every health component initialized by the HealthReporter at
+// the time the health of the component is set.
+func WithTransitionLogger(transitionLogger *healthlog.TransitionLogger) HealthReporterOption {
+	return func(reporter *healthReporter) {
+		reporter.transitionLogger = transitionLogger
+	}
 }
 
 // NewHealthReporter - creates a new HealthReporter; an implementation of status.HealthCheckSource
 // which initializes HealthComponents to report on the health of each individual health.CheckType.
-func NewHealthReporter() HealthReporter {
-	return newHealthReporter()
+func NewHealthReporter(options ...HealthReporterOption) HealthReporter {
+	return newHealthReporter(options...)
+}
+
+type HealthComponentOption func(conf *healthComponentConfig)
+
+type healthComponentConfig struct {
+	ttl              time.Duration
+	expiredState     health.HealthState_Value
+	historySize      int
+	historySummary   bool
+	errorStackFrames int
+	// scopeHooks are the hooks of the scoped reporters through which the component is registered.
+	scopeHooks []*healthHooks
+}
+
+func defaultHealthComponentConfig() healthComponentConfig {
+	return healthComponentConfig{
+		ttl:              0,
+		expiredState:     health.HealthState_REPAIRING,
+		historySize:      defaultHistorySize,
+		historySummary:   false,
+		errorStackFrames: 0,
+	}
+}
+
+func (h *healthComponentConfig) apply(options ...HealthComponentOption) {
+	for _, option := range options {
+		option(h)
+	}
 }
 
-func newHealthReporter() *healthReporter {
-	return &healthReporter{
-		healthComponents: make(map[health.CheckType]HealthComponent),
+// WithHealthStateTTL - makes every state set on the health component expire if it is not set again within ttl, for
+// example because the goroutine that owns the component died. An expired component reports the worse of its last
+// state and expiredState, with a param holding the time at which its state expired. Expiry is evaluated when the
+// health is read, and states set using SetHealthWithTTL use their own TTL and expire to expiredState. An expiry that
+// changes the state is recorded in the history of the component as a transition at the time the state expired.
+func WithHealthStateTTL(ttl time.Duration, expiredState health.HealthState_Value) HealthComponentOption {
+	return func(conf *healthComponentConfig) {
+		conf.ttl = ttl
+		conf.expiredState = expiredState
 	}
 }
 
+// WithHistorySize - sets the number of recent state transitions kept by the health component. Defaults to 16; a
+// non-positive size disables the history.
+func WithHistorySize(size int) HealthComponentOption {
+	return func(conf *healthComponentConfig) {
+		conf.historySize = size
+	}
+}
+
+// WithHistorySummary - adds a summary of the recent state transitions of the health component to the params of its
+// result, for example "ERROR since 2026-01-02T15:04:05Z, previously HEALTHY for 3h0m0s".
+func WithHistorySummary() HealthComponentOption {
+	return func(conf *healthComponentConfig) {
+		conf.historySummary = true
+	}
+}
+
+// WithErrorStack - adds up to maxFrames frames of the stack at which an error was created to the params of results set
+// using Error or ErrorWithParams. Only errors that carry a stack, such as those created using werror, have one.
+func WithErrorStack(maxFrames int) HealthComponentOption {
+	return func(conf *healthComponentConfig) {
+		conf.errorStackFrames = maxFrames
+	}
+}
+
+// withScopeHooks makes the health component deliver its events to hooks in addition to the hooks of its reporter.
+func withScopeHooks(hooks *healthHooks) HealthComponentOption {
+	return func(conf *healthComponentConfig) {
+		conf.scopeHooks = append(conf.scopeHooks, hooks)
+	}
+}
+
+func newHealthReporter(options ...HealthReporterOption) *healthReporter {
+	reporter := &healthReporter{}
+	reporter.healthComponents.Store(&map[health.CheckType]HealthComponent{})
+	// start at an arbitrary generation so that the generations of reporters in different processes are unlikely to
+	// collide, which could otherwise cause clients to reuse a stale health status after a restart.
+	reporter.generation.Store(uint64(time.Now().UnixNano()))
+	for _, option := range options {
+		option(reporter)
+	}
+	return reporter
+}
+
 // MustInitializeHealthComponent is a convenience function that calls InitializeHealthComponent on the provided
 // HealthReporter and panics if an error would have occurred
-func MustInitializeHealthComponent(hr HealthReporter, name string) HealthComponent {
-	healthComponent, err := hr.InitializeHealthComponent(name)
+func MustInitializeHealthComponent(hr HealthReporter, name string, options ...HealthComponentOption) HealthComponent {
+	healthComponent, err := hr.InitializeHealthComponent(name, options...)
 	if err != nil {
 		panic(err)
 	}
@@ -66,34 +167,55 @@ func MustInitializeHealthComponent(hr HealthReporter, name string) HealthCompone
 // initializing until a future call modifies the initializing status. The created health component is stored in the
 // HealthReporter and can be fetched later by name via GetHealthComponent.
 // Returns health.HealthState_ERROR if the component name is non-SLS compliant, or the name is already in use
-func (r *healthReporter) InitializeHealthComponent(name string) (HealthComponent, error) {
+func (r *healthReporter) InitializeHealthComponent(name string, options ...HealthComponentOption) (HealthComponent, error) {
 	isSLSCompliant := regexp.MustCompile(slsHealthNameRegex).MatchString
 	if !isSLSCompliant(name) {
 		return nil, werror.Error("component name is not a valid SLS health component name",
 			werror.SafeParam("name", name),
 			werror.SafeParam("validPattern", slsHealthNameRegex))
 	}
+	conf := defaultHealthComponentConfig()
+	conf.apply(options...)
 	componentName := health.CheckType(name)
+	if conf.historySize < 0 {
+		conf.historySize = 0
+	}
 	healthComponent := &healthComponent{
-		name:  componentName,
-		state: health.New_HealthState(health.HealthState_REPAIRING),
+		name:             componentName,
+		state:            health.New_HealthState(health.HealthState_REPAIRING),
+		history:          newTransitionHistory(conf.historySize),
+		defaultTTL:       conf.ttl,
+		expiredState:     conf.expiredState,
+		historySummary:   conf.historySummary,
+		errorStackFrames: conf.errorStackFrames,
+		transitionLogger: r.transitionLogger,
+		onChange:         r.incrementGeneration,
+		dispatcher:       &hookDispatcher{},
 	}
+	healthComponent.hookSets = append([]*healthHooks{&r.hooks, &healthComponent.hooks}, conf.scopeHooks...)
+	healthComponent.history.add(HealthTransition{
+		Time:  time.Now(),
+		State: health.HealthState_REPAIRING,
+	})
 
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, ok := r.healthComponents[componentName]; ok {
+	components := *r.healthComponents.Load()
+	if _, ok := components[componentName]; ok {
 		return nil, werror.Error("Health component name already exists", werror.SafeParam("name", name))
 	}
 
-	r.healthComponents[componentName] = healthComponent
+	updated := copyHealthComponents(components, len(components)+1)
+	updated[componentName] = healthComponent
+	r.healthComponents.Store(&updated)
+	r.incrementGeneration()
+	healthComponent.registered()
 	return healthComponent, nil
 }
 
 // GetHealthComponent - Gets an initialized health component by name.
 func (r *healthReporter) GetHealthComponent(name string) (HealthComponent, bool) {
-	r.mutex.RLock()
-	defer r.mutex.RUnlock()
-	c, ok := r.healthComponents[health.CheckType(name)]
+	c, ok := (*r.healthComponents.Load())[health.CheckType(name)]
 	return c, ok
 }
 
@@ -102,30 +224,108 @@ func (r *healthReporter) UnregisterHealthComponent(name string) bool {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	var changesMade bool
 	componentName := health.CheckType(name)
+	components := *r.healthComponents.Load()
+	component, present := components[componentName]
+	if !present {
+		return false
+	}
+
+	updated := copyHealthComponents(components, len(components))
+	delete(updated, componentName)
+	r.healthComponents.Store(&updated)
+	r.incrementGeneration()
+	notifyUnregistered(component)
+	return true
+}
+
+// unregisterHealthComponents removes the health components with the provided names at once and returns the number of
+// components that were removed.
+func (r *healthReporter) unregisterHealthComponents(names []string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 
-	if _, present := r.healthComponents[componentName]; present {
-		delete(r.healthComponents, componentName)
-		changesMade = true
+	components := *r.healthComponents.Load()
+	updated := copyHealthComponents(components, len(components))
+	for _, name := range names {
+		delete(updated, health.CheckType(name))
+	}
+	unregistered := len(components) - len(updated)
+	if unregistered == 0 {
+		return 0
 	}
+	r.healthComponents.Store(&updated)
+	r.incrementGeneration()
+	for name, component := range components {
+		if _, ok := updated[name]; !ok {
+			notifyUnregistered(component)
+		}
+	}
+	return unregistered
+}
 
-	return changesMade
+// AddHook - adds a hook that is called with the events of every health component of the reporter. Events of
+// components initialized before the hook was added are delivered from the time it was added onwards.
+func (r *healthReporter) AddHook(hook HealthHook) func() {
+	return r.hooks.add(hook)
+}
+
+func notifyUnregistered(component HealthComponent) {
+	if c, ok := component.(*healthComponent); ok {
+		c.unregistered()
+	}
 }
 
 // HealthStatus returns a copy of the current HealthStatus, and cannot be used to modify the current state
 func (r *healthReporter) HealthStatus(ctx context.Context) health.HealthStatus {
-	checks := make(map[health.CheckType]health.HealthCheckResult, len(r.healthComponents))
-	for checkType, component := range r.healthComponents {
+	return r.HealthStatusSnapshot(ctx).HealthStatus
+}
+
+// Generation - returns the current generation of the HealthReporter. States of health components that expired since
+// they were last read are accounted for, so that the generation changes whenever the health status does.
+func (r *healthReporter) Generation() uint64 {
+	r.reportExpiries()
+	return r.generation.Load()
+}
+
+// reportExpiries increments the generation for every health component whose state expired since it was last read.
+func (r *healthReporter) reportExpiries() {
+	for _, component := range *r.healthComponents.Load() {
+		if c, ok := component.(*healthComponent); ok {
+			c.reportExpiry()
+		}
+	}
+}
+
+// HealthStatusSnapshot - returns the current HealthStatus along with the generation it is at least as recent as.
+func (r *healthReporter) HealthStatusSnapshot(ctx context.Context) status.HealthStatusSnapshot {
+	// load the generation before the components so that a concurrent change results in a newer generation later on
+	generation := r.Generation()
+	components := *r.healthComponents.Load()
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(components))
+	for checkType, component := range components {
 		checks[checkType] = component.GetHealthCheck()
 	}
-	return health.HealthStatus{Checks: checks}
+	return status.HealthStatusSnapshot{
+		Generation:   generation,
+		HealthStatus: health.HealthStatus{Checks: checks},
+	}
+}
+
+func (r *healthReporter) incrementGeneration() {
+	r.generation.Add(1)
+}
+
+func copyHealthComponents(components map[health.CheckType]HealthComponent, size int) map[health.CheckType]HealthComponent {
+	copied := make(map[health.CheckType]HealthComponent, size)
+	for name, component := range components {
+		copied[name] = component
+	}
+	return copied
 }
 
 func (r *healthReporter) getHealthCheck(check health.CheckType) (health.HealthCheckResult, bool) {
-	r.mutex.RLock()
-	defer r.mutex.RUnlock()
-	component, found := r.healthComponents[check]
+	component, found := (*r.healthComponents.Load())[check]
 	if !found {
 		return health.HealthCheckResult{}, false
 	}
@@ -16,22 +16,26 @@ package reporter
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const testCheckType = health.CheckType("TEST")
 
 func TestGetHealthy(t *testing.T) {
 	reporter := newHealthReporter()
-	reporter.healthComponents = map[health.CheckType]HealthComponent{
+	reporter.healthComponents.Store(&map[health.CheckType]HealthComponent{
 		testCheckType: &healthComponent{
 			name:  testCheckType,
 			state: health.New_HealthState(health.HealthState_HEALTHY),
 		},
-	}
+	})
 
 	status, found := reporter.getHealthCheck(testCheckType)
 	assert.True(t, found)
@@ -40,12 +44,12 @@ func TestGetHealthy(t *testing.T) {
 
 func TestGetError(t *testing.T) {
 	reporter := newHealthReporter()
-	reporter.healthComponents = map[health.CheckType]HealthComponent{
+	reporter.healthComponents.Store(&map[health.CheckType]HealthComponent{
 		testCheckType: &healthComponent{
 			name:  testCheckType,
 			state: health.New_HealthState(health.HealthState_ERROR),
 		},
-	}
+	})
 
 	status, found := reporter.getHealthCheck(testCheckType)
 	assert.True(t, found)
@@ -111,3 +115,74 @@ func TestUnregisterOnUninitialized(t *testing.T) {
 	reporter := newHealthReporter()
 	assert.False(t, reporter.UnregisterHealthComponent(validComponent))
 }
+
+func TestHealthStatusSnapshotGeneration(t *testing.T) {
+	reporter := NewHealthReporter()
+	generation := reporter.Generation()
+
+	component, err := reporter.InitializeHealthComponent(validComponent)
+	require.NoError(t, err)
+	snapshot := reporter.HealthStatusSnapshot(context.TODO())
+	assert.Greater(t, snapshot.Generation, generation)
+	assert.Contains(t, snapshot.HealthStatus.Checks, health.CheckType(validComponent))
+
+	generation = reporter.Generation()
+	component.Healthy()
+	assert.Greater(t, reporter.Generation(), generation)
+
+	generation = reporter.Generation()
+	component.Healthy()
+	assert.Equal(t, generation, reporter.Generation())
+
+	assert.True(t, reporter.UnregisterHealthComponent(validComponent))
+	assert.Greater(t, reporter.Generation(), generation)
+	assert.Empty(t, reporter.HealthStatusSnapshot(context.TODO()).HealthStatus.Checks)
+}
+
+func TestHealthStatusSnapshotGenerationOnExpiry(t *testing.T) {
+	reporter := NewHealthReporter()
+	component, err := reporter.InitializeHealthComponent(validComponent, WithHealthStateTTL(10*time.Millisecond, health.HealthState_ERROR))
+	require.NoError(t, err)
+	component.Healthy()
+	generation := reporter.HealthStatusSnapshot(context.TODO()).Generation
+
+	time.Sleep(20 * time.Millisecond)
+	snapshot := reporter.HealthStatusSnapshot(context.TODO())
+	assert.Equal(t, health.HealthState_ERROR, snapshot.HealthStatus.Checks[validComponent].State.Value())
+	assert.Greater(t, snapshot.Generation, generation)
+}
+
+func TestGenerationOnExpiry(t *testing.T) {
+	reporter := NewHealthReporter()
+	component, err := reporter.InitializeHealthComponent(validComponent, WithHealthStateTTL(10*time.Millisecond, health.HealthState_ERROR))
+	require.NoError(t, err)
+	component.Healthy()
+	generation := reporter.Generation()
+
+	time.Sleep(20 * time.Millisecond)
+	expiredGeneration := reporter.Generation()
+	assert.Greater(t, expiredGeneration, generation)
+	assert.Equal(t, expiredGeneration, reporter.HealthStatusSnapshot(context.TODO()).Generation)
+}
+
+func TestConcurrentRegistrationAndHealthStatus(t *testing.T) {
+	reporter := NewHealthReporter()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		name := "COMPONENT_" + strings.Repeat("A", i+1)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			component, err := reporter.InitializeHealthComponent(name)
+			assert.NoError(t, err)
+			component.Healthy()
+			reporter.UnregisterHealthComponent(name)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = reporter.HealthStatus(context.TODO())
+		}()
+	}
+	wg.Wait()
+	assert.Empty(t, reporter.HealthStatus(context.TODO()).Checks)
+}
@@ -15,28 +15,204 @@
 package reporter
 
 import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
 	"github.com/palantir/witchcraft-go-health/status"
 )
 
 type ComponentName string
 
+// ReadinessState is the typed readiness state of a Component set using its typed helpers.
+type ReadinessState string
+
+const (
+	ReadinessStateReady    ReadinessState = "READY"
+	ReadinessStateNotReady ReadinessState = "NOT_READY"
+	ReadinessStateStarting ReadinessState = "STARTING"
+)
+
+// ReadinessMetadata is the metadata reported by a Component whose status was set using its typed helpers.
+type ReadinessMetadata struct {
+	State ReadinessState `json:"state"`
+	// Reason explains why the component is not ready.
+	Reason string `json:"reason,omitempty"`
+	// Progress is the fraction of startup work completed by a starting component, between 0 and 1.
+	Progress *float64 `json:"progress,omitempty"`
+	// BlockedBy lists the dependencies that are the root causes of the component not being ready.
+	BlockedBy []ComponentName `json:"blockedBy,omitempty"`
+}
+
 // Component represents an individual readiness check that's owned by the ReadinessReporter.
+// All methods are safe for concurrent use.
 type Component interface {
 	status.Source
 	SetStatus(respStatus int, metadata interface{})
+	// Ready marks the component as ready.
+	Ready()
+	// NotReady marks the component as not ready for the provided reason.
+	NotReady(reason string)
+	// Starting marks the component as not ready while it is starting up, with progress between 0 and 1.
+	Starting(progress float64)
+}
+
+type ReadinessComponentOption func(conf *readinessComponentConfig)
+
+type readinessComponentConfig struct {
+	latching               bool
+	dependencies           []ComponentName
+	startupDeadline        time.Duration
+	startupHealthComponent HealthComponent
+}
+
+func (r *readinessComponentConfig) apply(options ...ReadinessComponentOption) {
+	for _, option := range options {
+		option(r)
+	}
+}
+
+// WithLatching - makes the component latch once it first becomes ready: after that, statuses that are not ready are
+// ignored and the component stays ready. Useful for components that should only block readiness during startup, such
+// as an initial cache load.
+func WithLatching() ReadinessComponentOption {
+	return func(conf *readinessComponentConfig) {
+		conf.latching = true
+	}
+}
+
+// WithStartupDeadline - sets healthComponent to health.HealthState_TERMINAL if the component has not been ready
+// within deadline of its initialization. The health component is not restored if the component becomes ready later.
+func WithStartupDeadline(deadline time.Duration, healthComponent HealthComponent) ReadinessComponentOption {
+	return func(conf *readinessComponentConfig) {
+		conf.startupDeadline = deadline
+		conf.startupHealthComponent = healthComponent
+	}
 }
 
 type readinessComponent struct {
-	name     ComponentName
-	status   int
-	metadata interface{}
+	name         ComponentName
+	latching     bool
+	dependencies []ComponentName
+	// rootBlockers returns the root blockers among the provided dependencies; nil if the component has no dependencies.
+	rootBlockers func(dependencies []ComponentName) []ComponentName
+
+	// mutex protects access to `status`, `metadata`, `everReady` and `deadlineTimer`.
+	mutex         sync.RWMutex
+	status        int
+	metadata      interface{}
+	everReady     bool
+	deadlineTimer *time.Timer
+}
+
+func newReadinessComponent(name ComponentName, conf readinessComponentConfig) *readinessComponent {
+	component := &readinessComponent{
+		name:         name,
+		latching:     conf.latching,
+		dependencies: conf.dependencies,
+		// Initialize to not ready, mirroring how health components are initialized to REPAIRING.
+		status: http.StatusInternalServerError,
+	}
+	if conf.startupHealthComponent != nil {
+		// hold the lock so that the timer is assigned before it can fire
+		component.mutex.Lock()
+		defer component.mutex.Unlock()
+		component.deadlineTimer = time.AfterFunc(conf.startupDeadline, func() {
+			component.onStartupDeadline(conf.startupDeadline, conf.startupHealthComponent)
+		})
+	}
+	return component
+}
+
+// onStartupDeadline sets healthComponent to TERMINAL unless the component became ready. The lock is held while the
+// health is set so that the component cannot become ready in between.
+func (r *readinessComponent) onStartupDeadline(deadline time.Duration, healthComponent HealthComponent) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.everReady || r.deadlineTimer == nil {
+		return
+	}
+	// the deadline has fired, so the timer no longer needs to be stopped
+	r.deadlineTimer = nil
+	message := "Readiness component did not become ready within its startup deadline"
+	healthComponent.SetHealth(health.HealthState_TERMINAL, &message, map[string]interface{}{
+		"readinessComponent": r.name,
+		"startupDeadline":    deadline.String(),
+	})
+}
+
+// stop releases the resources of the component once it is unregistered.
+func (r *readinessComponent) stop() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.deadlineTimer != nil {
+		r.deadlineTimer.Stop()
+		r.deadlineTimer = nil
+	}
 }
 
 func (r *readinessComponent) Status() (respStatus int, metadata interface{}) {
+	respStatus, metadata = r.ownStatus()
+	if r.rootBlockers == nil {
+		return respStatus, metadata
+	}
+	return blockedStatus(respStatus, metadata, r.rootBlockers(r.dependencies))
+}
+
+// ownStatus returns the status set on the component, without considering its dependencies.
+func (r *readinessComponent) ownStatus() (respStatus int, metadata interface{}) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
 	return r.status, r.metadata
 }
 
 func (r *readinessComponent) SetStatus(respStatus int, metadata interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	ready := isReadyStatus(respStatus)
+	if r.latching && r.everReady && !ready {
+		return
+	}
 	r.status = respStatus
 	r.metadata = metadata
+	if ready && !r.everReady {
+		r.everReady = true
+		if r.deadlineTimer != nil {
+			r.deadlineTimer.Stop()
+			r.deadlineTimer = nil
+		}
+	}
+}
+
+func (r *readinessComponent) Ready() {
+	r.SetStatus(http.StatusOK, ReadinessMetadata{
+		State: ReadinessStateReady,
+	})
+}
+
+func (r *readinessComponent) NotReady(reason string) {
+	r.SetStatus(http.StatusServiceUnavailable, ReadinessMetadata{
+		State:  ReadinessStateNotReady,
+		Reason: reason,
+	})
+}
+
+func (r *readinessComponent) Starting(progress float64) {
+	if progress < 0 || math.IsNaN(progress) {
+		progress = 0
+	} else if progress > 1 {
+		progress = 1
+	}
+	r.SetStatus(http.StatusServiceUnavailable, ReadinessMetadata{
+		State:    ReadinessStateStarting,
+		Progress: &progress,
+	})
+}
+
+// isReadyStatus returns whether respStatus is a ready response code. Response codes within [200, 399] are considered
+// ready. Refer to readiness section of the SLS specification.
+func isReadyStatus(respStatus int) bool {
+	return respStatus >= 200 && respStatus < 400
 }
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"net/http"
+	"sort"
+)
+
+// WithDependencies - makes the component report not ready while any of the named components is not ready or not
+// registered. The metadata of a blocked component lists the root blockers: the unready dependencies, direct or
+// transitive, whose own dependencies are all ready. Dependencies that would introduce a cycle are rejected when the
+// component is initialized.
+func WithDependencies(names ...ComponentName) ReadinessComponentOption {
+	return func(conf *readinessComponentConfig) {
+		conf.dependencies = append(conf.dependencies, names...)
+	}
+}
+
+// blockedStatus returns the status of a component with the provided status that is blocked by blockers.
+func blockedStatus(respStatus int, metadata interface{}, blockers []ComponentName) (int, interface{}) {
+	if len(blockers) == 0 {
+		return respStatus, metadata
+	}
+	if isReadyStatus(respStatus) {
+		return http.StatusServiceUnavailable, ReadinessMetadata{
+			State:     ReadinessStateNotReady,
+			Reason:    "Waiting for dependencies to become ready",
+			BlockedBy: blockers,
+		}
+	}
+	if readinessMetadata, ok := metadata.(ReadinessMetadata); ok {
+		readinessMetadata.BlockedBy = blockers
+		return respStatus, readinessMetadata
+	}
+	return respStatus, metadata
+}
+
+func componentDependencies(component Component) []ComponentName {
+	if readinessComponent, ok := component.(*readinessComponent); ok {
+		return readinessComponent.dependencies
+	}
+	return nil
+}
+
+func componentOwnStatus(component Component) int {
+	if readinessComponent, ok := component.(*readinessComponent); ok {
+		respStatus, _ := readinessComponent.ownStatus()
+		return respStatus
+	}
+	respStatus, _ := component.Status()
+	return respStatus
+}
+
+// dependencyCycleLocked returns the cycle that registering a component with the provided name and dependencies would
+// introduce, or nil if there is none. The caller must hold the mutex.
+func (r *readinessReporter) dependencyCycleLocked(name ComponentName, dependencies []ComponentName) []ComponentName {
+	visited := make(map[ComponentName]bool)
+	var visit func(current ComponentName, path []ComponentName) []ComponentName
+	visit = func(current ComponentName, path []ComponentName) []ComponentName {
+		path = append(path, current)
+		if current == name {
+			return path
+		}
+		if visited[current] {
+			return nil
+		}
+		visited[current] = true
+		component, exists := r.readinessComponents[current]
+		if !exists {
+			return nil
+		}
+		for _, dependency := range componentDependencies(component) {
+			if cycle := visit(dependency, path); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	for _, dependency := range dependencies {
+		if cycle := visit(dependency, []ComponentName{name}); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+func (r *readinessReporter) rootBlockers(dependencies []ComponentName) []ComponentName {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.rootBlockersLocked(dependencies, make(map[ComponentName]bool))
+}
+
+// rootBlockersLocked returns the sorted root blockers among dependencies. readiness memoizes whether components are
+// ready considering their dependencies. The caller must hold the mutex.
+func (r *readinessReporter) rootBlockersLocked(dependencies []ComponentName, readiness map[ComponentName]bool) []ComponentName {
+	blockers := make(map[ComponentName]struct{})
+	visited := make(map[ComponentName]struct{})
+	var visit func(name ComponentName)
+	visit = func(name ComponentName) {
+		if _, ok := visited[name]; ok {
+			return
+		}
+		visited[name] = struct{}{}
+		if r.readyLocked(name, readiness) {
+			return
+		}
+		var unreadyDependencies []ComponentName
+		if component, exists := r.readinessComponents[name]; exists {
+			for _, dependency := range componentDependencies(component) {
+				if !r.readyLocked(dependency, readiness) {
+					unreadyDependencies = append(unreadyDependencies, dependency)
+				}
+			}
+		}
+		if len(unreadyDependencies) == 0 {
+			blockers[name] = struct{}{}
+			return
+		}
+		for _, dependency := range unreadyDependencies {
+			visit(dependency)
+		}
+	}
+	for _, dependency := range dependencies {
+		visit(dependency)
+	}
+	if len(blockers) == 0 {
+		return nil
+	}
+	sorted := make([]ComponentName, 0, len(blockers))
+	for name := range blockers {
+		sorted = append(sorted, name)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
+
+// readyLocked returns whether the named component is registered and ready considering its dependencies. The caller
+// must hold the mutex.
+func (r *readinessReporter) readyLocked(name ComponentName, readiness map[ComponentName]bool) bool {
+	if ready, ok := readiness[name]; ok {
+		return ready
+	}
+	component, exists := r.readinessComponents[name]
+	ready := exists && isReadyStatus(componentOwnStatus(component))
+	for _, dependency := range componentDependencies(component) {
+		if !ready {
+			break
+		}
+		ready = r.readyLocked(dependency, readiness)
+	}
+	readiness[name] = ready
+	return ready
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadinessDependencies(t *testing.T) {
+	ctx := context.TODO()
+	reporter := NewReadinessReporter()
+	database, err := reporter.InitializeReadinessComponent(ctx, "DATABASE")
+	require.NoError(t, err)
+	cache, err := reporter.InitializeReadinessComponent(ctx, "CACHE", WithDependencies("DATABASE"))
+	require.NoError(t, err)
+	server, err := reporter.InitializeReadinessComponent(ctx, "SERVER", WithDependencies("CACHE", "CONFIG"))
+	require.NoError(t, err)
+
+	database.NotReady("connecting")
+	cache.Ready()
+	server.Ready()
+
+	respStatus, metadata := server.Status()
+	assert.Equal(t, http.StatusServiceUnavailable, respStatus)
+	assert.Equal(t, ReadinessMetadata{
+		State:     ReadinessStateNotReady,
+		Reason:    "Waiting for dependencies to become ready",
+		BlockedBy: []ComponentName{"CONFIG", "DATABASE"},
+	}, metadata)
+
+	respStatus, aggregated := reporter.Status()
+	assert.Equal(t, http.StatusServiceUnavailable, respStatus)
+	assert.Equal(t, map[ComponentName]interface{}{
+		"DATABASE": ReadinessMetadata{State: ReadinessStateNotReady, Reason: "connecting"},
+		"CACHE": ReadinessMetadata{
+			State:     ReadinessStateNotReady,
+			Reason:    "Waiting for dependencies to become ready",
+			BlockedBy: []ComponentName{"DATABASE"},
+		},
+		"SERVER": ReadinessMetadata{
+			State:     ReadinessStateNotReady,
+			Reason:    "Waiting for dependencies to become ready",
+			BlockedBy: []ComponentName{"CONFIG", "DATABASE"},
+		},
+	}, aggregated)
+
+	database.Ready()
+	config, err := reporter.InitializeReadinessComponent(ctx, "CONFIG")
+	require.NoError(t, err)
+	config.Starting(0.5)
+	_, metadata = server.Status()
+	assert.Equal(t, []ComponentName{"CONFIG"}, metadata.(ReadinessMetadata).BlockedBy)
+
+	config.Ready()
+	respStatus, metadata = server.Status()
+	assert.Equal(t, http.StatusOK, respStatus)
+	assert.Equal(t, ReadinessMetadata{State: ReadinessStateReady}, metadata)
+	respStatus, _ = reporter.Status()
+	assert.Equal(t, http.StatusOK, respStatus)
+}
+
+func TestReadinessDependenciesRejectCycles(t *testing.T) {
+	ctx := context.TODO()
+	reporter := NewReadinessReporter()
+	_, err := reporter.InitializeReadinessComponent(ctx, "A", WithDependencies("B"))
+	require.NoError(t, err)
+	_, err = reporter.InitializeReadinessComponent(ctx, "B", WithDependencies("C"))
+	require.NoError(t, err)
+
+	_, err = reporter.InitializeReadinessComponent(ctx, "C", WithDependencies("A"))
+	assert.Error(t, err)
+	_, err = reporter.InitializeReadinessComponent(ctx, "D", WithDependencies("D"))
+	assert.Error(t, err)
+	_, err = reporter.InitializeReadinessComponent(ctx, "C", WithDependencies("D"))
+	assert.NoError(t, err)
+}
@@ -27,7 +27,7 @@ import (
 // Reporter allows for the creation and aggregation of custom readiness checks.
 type Reporter interface {
 	status.Source
-	InitializeReadinessComponent(ctx context.Context, name ComponentName) (Component, error)
+	InitializeReadinessComponent(ctx context.Context, name ComponentName, options ...ReadinessComponentOption) (Component, error)
 	GetReadinessComponent(name ComponentName) (Component, bool)
 	UnregisterReadinessComponent(ctx context.Context, name ComponentName) bool
 }
@@ -51,11 +51,18 @@ func (r *readinessReporter) Status() (respStatus int, metadata interface{}) {
 	// Attempt to return the highest "unready" response code. If none exist, return ready.
 	highestUnreadyRespStatus := 0
 	aggregatedMetadata := make(map[ComponentName]interface{})
+	readiness := make(map[ComponentName]bool)
 	for name, component := range r.readinessComponents {
-		respStatus, metadata := component.Status()
-		// Response codes within [200, 399] are considered ready.
-		//   Refer to readiness section of the SLS specification.
-		if (respStatus < 200 || respStatus >= 400) && respStatus > highestUnreadyRespStatus {
+		var respStatus int
+		var metadata interface{}
+		if readinessComponent, ok := component.(*readinessComponent); ok {
+			// evaluate dependencies using the held lock rather than through readinessComponent.Status
+			respStatus, metadata = readinessComponent.ownStatus()
+			respStatus, metadata = blockedStatus(respStatus, metadata, r.rootBlockersLocked(readinessComponent.dependencies, readiness))
+		} else {
+			respStatus, metadata = component.Status()
+		}
+		if !isReadyStatus(respStatus) && respStatus > highestUnreadyRespStatus {
 			highestUnreadyRespStatus = respStatus
 		}
 		aggregatedMetadata[name] = metadata
@@ -66,13 +73,13 @@ func (r *readinessReporter) Status() (respStatus int, metadata interface{}) {
 	return highestUnreadyRespStatus, aggregatedMetadata
 }
 
-func (r *readinessReporter) InitializeReadinessComponent(ctx context.Context, name ComponentName) (Component, error) {
+func (r *readinessReporter) InitializeReadinessComponent(ctx context.Context, name ComponentName, options ...ReadinessComponentOption) (Component, error) {
 	ctx = svc1log.WithLoggerParams(ctx, svc1log.SafeParam("readinessComponent", name))
-	var component Component
-	component = &readinessComponent{
-		name: name,
-		// Initialize to not ready, mirroring how health components are initialized to REPAIRING.
-		status: http.StatusInternalServerError,
+	var conf readinessComponentConfig
+	conf.apply(options...)
+	if conf.startupHealthComponent != nil && conf.startupDeadline <= 0 {
+		return nil, werror.ErrorWithContextParams(ctx, "startup deadline must be positive",
+			werror.SafeParam("startupDeadline", conf.startupDeadline.String()))
 	}
 
 	r.mutex.Lock()
@@ -81,6 +88,15 @@ func (r *readinessReporter) InitializeReadinessComponent(ctx context.Context, na
 		return nil, werror.ErrorWithContextParams(ctx, "readiness component already exists")
 	}
 
+	if cycle := r.dependencyCycleLocked(name, conf.dependencies); cycle != nil {
+		return nil, werror.ErrorWithContextParams(ctx, "readiness component dependencies introduce a cycle",
+			werror.SafeParam("cycle", cycle))
+	}
+
+	component := newReadinessComponent(name, conf)
+	if len(conf.dependencies) > 0 {
+		component.rootBlockers = r.rootBlockers
+	}
 	r.readinessComponents[name] = component
 	svc1log.FromContext(ctx).Info("Registered new readiness component.")
 	return component, nil
@@ -96,9 +112,13 @@ func (r *readinessReporter) GetReadinessComponent(name ComponentName) (Component
 func (r *readinessReporter) UnregisterReadinessComponent(ctx context.Context, name ComponentName) bool {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, exists := r.readinessComponents[name]; !exists {
+	component, exists := r.readinessComponents[name]
+	if !exists {
 		return false
 	}
+	if readinessComponent, ok := component.(*readinessComponent); ok {
+		readinessComponent.stop()
+	}
 	delete(r.readinessComponents, name)
 	svc1log.FromContext(ctx).Info("Unregistered readiness component.", svc1log.SafeParam("readinessComponent", name))
 	return true
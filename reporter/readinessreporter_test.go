@@ -17,8 +17,11 @@ package reporter
 import (
 	"context"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -157,3 +160,111 @@ func TestInitializeExistingComponent(t *testing.T) {
 	assert.Nil(t, component)
 	assert.Error(t, err)
 }
+
+func TestTypedReadinessStates(t *testing.T) {
+	ctx := context.TODO()
+	reporter := NewReadinessReporter()
+	component, err := reporter.InitializeReadinessComponent(ctx, "TEST_CHECK")
+	require.NoError(t, err)
+
+	component.Starting(1.5)
+	respStatus, metadata := component.Status()
+	assert.Equal(t, http.StatusServiceUnavailable, respStatus)
+	progress := 1.0
+	assert.Equal(t, ReadinessMetadata{State: ReadinessStateStarting, Progress: &progress}, metadata)
+
+	component.NotReady("waiting for leader election")
+	respStatus, metadata = component.Status()
+	assert.Equal(t, http.StatusServiceUnavailable, respStatus)
+	assert.Equal(t, ReadinessMetadata{State: ReadinessStateNotReady, Reason: "waiting for leader election"}, metadata)
+
+	component.Ready()
+	respStatus, metadata = reporter.Status()
+	assert.Equal(t, http.StatusOK, respStatus)
+	assert.Equal(t, map[ComponentName]interface{}{
+		"TEST_CHECK": ReadinessMetadata{State: ReadinessStateReady},
+	}, metadata)
+}
+
+func TestConcurrentReadinessUpdates(t *testing.T) {
+	ctx := context.TODO()
+	reporter := NewReadinessReporter()
+	component, err := reporter.InitializeReadinessComponent(ctx, "TEST_CHECK")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				component.Ready()
+			} else {
+				component.NotReady("not ready")
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, _ = reporter.Status()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLatchingReadinessComponent(t *testing.T) {
+	ctx := context.TODO()
+	reporter := NewReadinessReporter()
+	component, err := reporter.InitializeReadinessComponent(ctx, "CACHE_LOAD", WithLatching())
+	require.NoError(t, err)
+
+	component.Starting(0.5)
+	respStatus, _ := component.Status()
+	assert.Equal(t, http.StatusServiceUnavailable, respStatus)
+
+	component.Ready()
+	component.NotReady("cache reload failed")
+	respStatus, metadata := component.Status()
+	assert.Equal(t, http.StatusOK, respStatus)
+	assert.Equal(t, ReadinessMetadata{State: ReadinessStateReady}, metadata)
+}
+
+func TestReadinessComponentStartupDeadline(t *testing.T) {
+	ctx := context.TODO()
+	reporter := NewReadinessReporter()
+	healthReporter := NewHealthReporter()
+	healthComponent, err := healthReporter.InitializeHealthComponent("STARTUP")
+	require.NoError(t, err)
+	healthComponent.Healthy()
+
+	_, err = reporter.InitializeReadinessComponent(ctx, "SLOW", WithStartupDeadline(10*time.Millisecond, healthComponent))
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return healthComponent.Status() == health.HealthState_TERMINAL
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, ComponentName("SLOW"), healthComponent.GetHealthCheck().Params["readinessComponent"])
+
+	otherHealthComponent, err := healthReporter.InitializeHealthComponent("OTHER_STARTUP")
+	require.NoError(t, err)
+	otherHealthComponent.Healthy()
+	component, err := reporter.InitializeReadinessComponent(ctx, "FAST", WithLatching(), WithStartupDeadline(20*time.Millisecond, otherHealthComponent))
+	require.NoError(t, err)
+	component.Ready()
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, health.HealthState_HEALTHY, otherHealthComponent.Status())
+
+	_, err = reporter.InitializeReadinessComponent(ctx, "INVALID", WithStartupDeadline(0, otherHealthComponent))
+	assert.Error(t, err)
+}
+
+func TestReadinessComponentStartupDeadlineAfterReady(t *testing.T) {
+	// a deadline that fires after the component became ready has no effect
+	healthComponent, _ := setup(t)
+	healthComponent.Healthy()
+	component := newReadinessComponent("COMPONENT", readinessComponentConfig{
+		startupDeadline:        time.Hour,
+		startupHealthComponent: healthComponent,
+	})
+	component.Ready()
+	component.onStartupDeadline(time.Hour, healthComponent)
+	assert.Equal(t, health.HealthState_HEALTHY, healthComponent.Status())
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+var _ ScopedHealthReporter = &scopedHealthReporter{}
+
+// ScopedHealthReporter is a HealthReporter that registers its health components on a parent HealthReporter with
+// their names prefixed by the scope followed by an underscore. Names passed to and returned by its methods are
+// unprefixed, while the check types in its health status are prefixed, matching those of the parent.
+type ScopedHealthReporter interface {
+	HealthReporter
+	// Scope returns the scope of the reporter.
+	Scope() string
+	// UnregisterScope unregisters all health components registered through the reporter from the parent and returns
+	// the number of components that were unregistered.
+	UnregisterScope() int
+}
+
+// bulkUnregisterer is implemented by reporters that can unregister multiple health components at once.
+type bulkUnregisterer interface {
+	unregisterHealthComponents(names []string) int
+}
+
+type scopedHealthReporter struct {
+	parent HealthReporter
+	scope  string
+
+	// mutex protects access to `names`.
+	mutex sync.RWMutex
+	// names are the unprefixed names of the health components registered through the reporter.
+	names map[string]struct{}
+	// hooks are the hooks added to the reporter, which are called by the health components registered through it.
+	hooks healthHooks
+}
+
+func MustNewScopedHealthReporter(parent HealthReporter, scope string) ScopedHealthReporter {
+	scoped, err := NewScopedHealthReporter(parent, scope)
+	if err != nil {
+		panic(err)
+	}
+	return scoped
+}
+
+// NewScopedHealthReporter - creates a ScopedHealthReporter that registers its health components on parent with their
+// names prefixed by scope, for example "BILLING_DATABASE" for a component named "DATABASE" in the "BILLING" scope.
+// Shared libraries can use a scoped reporter to avoid colliding on component names while the parent keeps reporting a
+// single flat health status. Returns an error if scope is not a valid SLS health component name.
+func NewScopedHealthReporter(parent HealthReporter, scope string) (ScopedHealthReporter, error) {
+	if !regexp.MustCompile(slsHealthNameRegex).MatchString(scope) {
+		return nil, werror.Error("scope is not a valid SLS health component name",
+			werror.SafeParam("scope", scope),
+			werror.SafeParam("validPattern", slsHealthNameRegex))
+	}
+	return &scopedHealthReporter{
+		parent: parent,
+		scope:  scope,
+		names:  make(map[string]struct{}),
+	}, nil
+}
+
+func (r *scopedHealthReporter) Scope() string {
+	return r.scope
+}
+
+func (r *scopedHealthReporter) prefixed(name string) string {
+	return r.scope + "_" + name
+}
+
+func (r *scopedHealthReporter) InitializeHealthComponent(name string, options ...HealthComponentOption) (HealthComponent, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	options = append(options[:len(options):len(options)], withScopeHooks(&r.hooks))
+	component, err := r.parent.InitializeHealthComponent(r.prefixed(name), options...)
+	if err != nil {
+		return nil, werror.Wrap(err, "failed to initialize scoped health component",
+			werror.SafeParam("scope", r.scope))
+	}
+	r.names[name] = struct{}{}
+	return component, nil
+}
+
+func (r *scopedHealthReporter) GetHealthComponent(name string) (HealthComponent, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if _, ok := r.names[name]; !ok {
+		return nil, false
+	}
+	return r.parent.GetHealthComponent(r.prefixed(name))
+}
+
+func (r *scopedHealthReporter) UnregisterHealthComponent(name string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.names[name]; !ok {
+		return false
+	}
+	delete(r.names, name)
+	return r.parent.UnregisterHealthComponent(r.prefixed(name))
+}
+
+func (r *scopedHealthReporter) UnregisterScope() int {
+	r.mutex.Lock()
+	names := make([]string, 0, len(r.names))
+	for name := range r.names {
+		names = append(names, name)
+	}
+	r.mutex.Unlock()
+	return r.unregisterHealthComponents(names)
+}
+
+func (r *scopedHealthReporter) unregisterHealthComponents(names []string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	prefixedNames := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, ok := r.names[name]; !ok {
+			continue
+		}
+		delete(r.names, name)
+		prefixedNames = append(prefixedNames, r.prefixed(name))
+	}
+	if bulk, ok := r.parent.(bulkUnregisterer); ok {
+		return bulk.unregisterHealthComponents(prefixedNames)
+	}
+	unregistered := 0
+	for _, name := range prefixedNames {
+		if r.parent.UnregisterHealthComponent(name) {
+			unregistered++
+		}
+	}
+	return unregistered
+}
+
+// AddHook - adds a hook that is only called with the events of health components registered through the reporter,
+// including the events of their registration and unregistration. The check types of the events are prefixed by the
+// scope. Hooks are only called if the parent was created using NewHealthReporter or NewScopedHealthReporter.
+func (r *scopedHealthReporter) AddHook(hook HealthHook) func() {
+	return r.hooks.add(hook)
+}
+
+func (r *scopedHealthReporter) HealthStatus(ctx context.Context) health.HealthStatus {
+	return r.HealthStatusSnapshot(ctx).HealthStatus
+}
+
+// Generation - returns the generation of the parent, which increases whenever a component of the scope changes.
+func (r *scopedHealthReporter) Generation() uint64 {
+	return r.parent.Generation()
+}
+
+func (r *scopedHealthReporter) HealthStatusSnapshot(ctx context.Context) status.HealthStatusSnapshot {
+	generation := r.parent.Generation()
+	r.mutex.RLock()
+	names := make([]string, 0, len(r.names))
+	for name := range r.names {
+		names = append(names, name)
+	}
+	r.mutex.RUnlock()
+
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(names))
+	for _, name := range names {
+		component, ok := r.parent.GetHealthComponent(r.prefixed(name))
+		if !ok {
+			continue
+		}
+		result := component.GetHealthCheck()
+		checks[result.Type] = result
+	}
+	return status.HealthStatusSnapshot{
+		Generation:   generation,
+		HealthStatus: health.HealthStatus{Checks: checks},
+	}
+}
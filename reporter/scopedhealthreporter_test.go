@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopedHealthReporter(t *testing.T) {
+	parent := NewHealthReporter()
+	_, err := parent.InitializeHealthComponent("DATABASE")
+	require.NoError(t, err)
+
+	billing, err := NewScopedHealthReporter(parent, "BILLING")
+	require.NoError(t, err)
+	assert.Equal(t, "BILLING", billing.Scope())
+	database, err := billing.InitializeHealthComponent("DATABASE")
+	require.NoError(t, err)
+	database.Healthy()
+	_, err = billing.InitializeHealthComponent("DATABASE")
+	assert.Error(t, err)
+	_, err = billing.InitializeHealthComponent("invalid")
+	assert.Error(t, err)
+
+	ledger := MustNewScopedHealthReporter(billing, "LEDGER")
+	_, err = ledger.InitializeHealthComponent("QUEUE")
+	require.NoError(t, err)
+
+	fromGet, ok := billing.GetHealthComponent("DATABASE")
+	assert.True(t, ok)
+	assert.Equal(t, database, fromGet)
+	_, ok = billing.GetHealthComponent("BILLING_DATABASE")
+	assert.False(t, ok)
+
+	assert.ElementsMatch(t, []health.CheckType{"DATABASE", "BILLING_DATABASE", "BILLING_LEDGER_QUEUE"}, checkTypes(parent.HealthStatus(context.TODO())))
+	assert.ElementsMatch(t, []health.CheckType{"BILLING_DATABASE", "BILLING_LEDGER_QUEUE"}, checkTypes(billing.HealthStatus(context.TODO())))
+	assert.ElementsMatch(t, []health.CheckType{"BILLING_LEDGER_QUEUE"}, checkTypes(ledger.HealthStatus(context.TODO())))
+	assert.Equal(t, health.HealthState_HEALTHY, billing.HealthStatus(context.TODO()).Checks["BILLING_DATABASE"].State.Value())
+
+	generation := parent.Generation()
+	assert.Equal(t, 2, billing.UnregisterScope())
+	assert.Equal(t, generation+1, parent.Generation())
+	assert.ElementsMatch(t, []health.CheckType{"DATABASE"}, checkTypes(parent.HealthStatus(context.TODO())))
+	assert.Empty(t, billing.HealthStatus(context.TODO()).Checks)
+	assert.Empty(t, ledger.HealthStatus(context.TODO()).Checks)
+	assert.Equal(t, 0, billing.UnregisterScope())
+
+	_, err = billing.InitializeHealthComponent("DATABASE")
+	assert.NoError(t, err)
+	assert.True(t, billing.UnregisterHealthComponent("DATABASE"))
+	assert.False(t, billing.UnregisterHealthComponent("DATABASE"))
+
+	_, err = NewScopedHealthReporter(parent, "billing")
+	assert.Error(t, err)
+}
+
+func checkTypes(healthStatus health.HealthStatus) []health.CheckType {
+	var checkTypes []health.CheckType
+	for checkType := range healthStatus.Checks {
+		checkTypes = append(checkTypes, checkType)
+	}
+	return checkTypes
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cached
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+)
+
+// PanicParam is the unsafe param of the ERROR result cached by a refreshing source when the wrapped source panics.
+// Its value is the recovered panic value formatted using fmt.Sprint.
+const PanicParam = "healthCheckPanic"
+
+type refreshingHealthCheckSource struct {
+	source          status.HealthCheckSource
+	refreshInterval time.Duration
+
+	// mutex protects access to `cached`.
+	mutex  sync.RWMutex
+	cached *snapshot
+}
+
+func MustNewRefreshingHealthCheckSource(ctx context.Context, source status.HealthCheckSource, refreshInterval time.Duration) status.HealthCheckSource {
+	healthCheckSource, err := NewRefreshingHealthCheckSource(ctx, source, refreshInterval)
+	if err != nil {
+		panic(err)
+	}
+	return healthCheckSource
+}
+
+// NewRefreshingHealthCheckSource returns a source that calls the wrapped source in the background every
+// refreshInterval until ctx is done and serves the most recent result. Until the first background call completes,
+// calls are passed through to the wrapped source. If the wrapped source panics during a background call, an ERROR
+// result of type status.PanickedHealthCheckSourceCheckType is served until the next background call completes.
+func NewRefreshingHealthCheckSource(ctx context.Context, source status.HealthCheckSource, refreshInterval time.Duration) (status.HealthCheckSource, error) {
+	if refreshInterval <= 0 {
+		return nil, werror.Error("refreshInterval must be positive",
+			werror.SafeParam("refreshInterval", refreshInterval.String()))
+	}
+	refreshing := &refreshingHealthCheckSource{
+		source:          source,
+		refreshInterval: refreshInterval,
+	}
+	go wapp.RunWithRecoveryLogging(ctx, refreshing.runRefresh)
+	return refreshing, nil
+}
+
+func (r *refreshingHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
+	r.mutex.RLock()
+	cached := r.cached
+	r.mutex.RUnlock()
+	if cached == nil {
+		return r.source.HealthStatus(ctx)
+	}
+	return cached.withAge()
+}
+
+func (r *refreshingHealthCheckSource) runRefresh(ctx context.Context) {
+	ticker := time.NewTicker(r.refreshInterval)
+	defer ticker.Stop()
+	r.doRefresh(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.doRefresh(ctx)
+		}
+	}
+}
+
+// doRefresh calls the wrapped source and caches its result. If the wrapped source panics, an ERROR result is cached
+// instead so that the panic does not stop the refresh loop or leave a stale result cached.
+func (r *refreshingHealthCheckSource) doRefresh(ctx context.Context) {
+	var healthStatus health.HealthStatus
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				healthStatus = panickedHealthStatus(recovered)
+			}
+		}()
+		healthStatus = r.source.HealthStatus(ctx)
+	}()
+	cached := &snapshot{
+		healthStatus: healthStatus,
+		time:         time.Now(),
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cached = cached
+}
+
+func panickedHealthStatus(recovered interface{}) health.HealthStatus {
+	message := "Health check source panicked while refreshing"
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			status.PanickedHealthCheckSourceCheckType: {
+				Type:    status.PanickedHealthCheckSourceCheckType,
+				State:   health.New_HealthState(health.HealthState_ERROR),
+				Message: &message,
+				Params: map[string]interface{}{
+					PanicParam: status.NewUnsafeParam(fmt.Sprint(recovered)),
+				},
+			},
+		},
+	}
+}
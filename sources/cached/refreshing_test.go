@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cached
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshingHealthCheckSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := &countingHealthCheckSource{}
+	refreshingSource, err := NewRefreshingHealthCheckSource(ctx, source, 20*time.Millisecond)
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&source.calls) >= 3
+	}, time.Second, 5*time.Millisecond)
+
+	healthStatus := refreshingSource.HealthStatus(context.Background())
+	assert.GreaterOrEqual(t, healthStatus.Checks[testCheckType].Params["calls"], int32(2))
+	assert.Contains(t, healthStatus.Checks[testCheckType].Params, CacheAgeParam)
+
+	cancel()
+	time.Sleep(30 * time.Millisecond)
+	calls := atomic.LoadInt32(&source.calls)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, calls, atomic.LoadInt32(&source.calls))
+}
+
+func TestRefreshingHealthCheckSource_Panic(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int32
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		if atomic.AddInt32(&calls, 1) == 2 {
+			panic("refresh failed")
+		}
+		return health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				testCheckType: {
+					Type:  testCheckType,
+					State: health.New_HealthState(health.HealthState_HEALTHY),
+				},
+			},
+		}
+	})
+	refreshingSource, err := NewRefreshingHealthCheckSource(ctx, source, 20*time.Millisecond)
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		result, ok := refreshingSource.HealthStatus(context.Background()).Checks[status.PanickedHealthCheckSourceCheckType]
+		return ok && result.State.Value() == health.HealthState_ERROR &&
+			result.Params[PanicParam] == status.NewUnsafeParam("refresh failed")
+	}, time.Second, time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		healthStatus := refreshingSource.HealthStatus(context.Background())
+		_, ok := healthStatus.Checks[testCheckType]
+		return ok && atomic.LoadInt32(&calls) >= 3
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestNewRefreshingHealthCheckSource_InvalidInterval(t *testing.T) {
+	_, err := NewRefreshingHealthCheckSource(context.Background(), &countingHealthCheckSource{}, 0)
+	assert.Error(t, err)
+}
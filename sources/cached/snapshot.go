@@ -0,0 +1,46 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cached
+
+import (
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// CacheAgeParam is the param added to every check reported from a cached result. Its value is the age of the cached
+// result formatted using time.Duration.String.
+const CacheAgeParam = "cacheAge"
+
+type snapshot struct {
+	healthStatus health.HealthStatus
+	time         time.Time
+}
+
+// withAge returns a copy of the cached health status with the age of the snapshot added to the params of each check.
+func (s *snapshot) withAge() health.HealthStatus {
+	age := time.Since(s.time).String()
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(s.healthStatus.Checks))
+	for checkType, result := range s.healthStatus.Checks {
+		params := make(map[string]interface{}, len(result.Params)+1)
+		for k, v := range result.Params {
+			params[k] = v
+		}
+		params[CacheAgeParam] = age
+		result.Params = params
+		checks[checkType] = result
+	}
+	return health.HealthStatus{Checks: checks}
+}
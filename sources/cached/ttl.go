@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cached
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+const defaultRefreshTimeout = 10 * time.Second
+
+type ttlHealthCheckSource struct {
+	source         status.HealthCheckSource
+	ttl            time.Duration
+	refreshTimeout time.Duration
+
+	// mutex protects access to `cached` and `inFlight`.
+	mutex  sync.Mutex
+	cached *snapshot
+	// inFlight is non-nil while a call to the wrapped source is in progress.
+	inFlight *ttlCall
+}
+
+// ttlCall is a call to the wrapped source whose outcome is set before done is closed.
+type ttlCall struct {
+	done       chan struct{}
+	result     *snapshot
+	panicked   bool
+	panicValue interface{}
+}
+
+type TTLOption func(conf *ttlConfig)
+
+type ttlConfig struct {
+	refreshTimeout time.Duration
+}
+
+func defaultTTLConfig() ttlConfig {
+	return ttlConfig{
+		refreshTimeout: defaultRefreshTimeout,
+	}
+}
+
+func (t *ttlConfig) apply(options ...TTLOption) {
+	for _, option := range options {
+		option(t)
+	}
+}
+
+// WithRefreshTimeout sets the timeout of the context of calls to the wrapped source. Defaults to 10 seconds; a
+// non-positive timeout disables the timeout.
+func WithRefreshTimeout(refreshTimeout time.Duration) TTLOption {
+	return func(conf *ttlConfig) {
+		conf.refreshTimeout = refreshTimeout
+	}
+}
+
+func MustNewTTLHealthCheckSource(source status.HealthCheckSource, ttl time.Duration, options ...TTLOption) status.HealthCheckSource {
+	healthCheckSource, err := NewTTLHealthCheckSource(source, ttl, options...)
+	if err != nil {
+		panic(err)
+	}
+	return healthCheckSource
+}
+
+// NewTTLHealthCheckSource returns a source that lazily calls the wrapped source and reuses its result until it is
+// older than ttl. Concurrent callers that find the cached result expired share a single call to the wrapped source.
+// The shared call is made with a context that carries the values of the context of the first of those callers but is
+// not cancelled with it, and that times out after the refresh timeout, so that one cancelled caller cannot spoil the
+// result for every other caller. Callers whose context is done before the shared call completes get the previous
+// result, or a health.HealthState_REPAIRING status.TimedOutHealthCheckSourceCheckType check if there is none. If the
+// wrapped source panics, callers sharing the call panic with the same value and the cached result is left unchanged.
+func NewTTLHealthCheckSource(source status.HealthCheckSource, ttl time.Duration, options ...TTLOption) (status.HealthCheckSource, error) {
+	if ttl <= 0 {
+		return nil, werror.Error("ttl must be positive",
+			werror.SafeParam("ttl", ttl.String()))
+	}
+	conf := defaultTTLConfig()
+	conf.apply(options...)
+	return &ttlHealthCheckSource{
+		source:         source,
+		ttl:            ttl,
+		refreshTimeout: conf.refreshTimeout,
+	}, nil
+}
+
+func (t *ttlHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
+	t.mutex.Lock()
+	cached := t.cached
+	if cached != nil && time.Since(cached.time) < t.ttl {
+		t.mutex.Unlock()
+		return cached.withAge()
+	}
+	call := t.inFlight
+	if call == nil {
+		call = &ttlCall{done: make(chan struct{})}
+		t.inFlight = call
+		go t.refresh(ctx, call)
+	}
+	t.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		if call.panicked {
+			panic(call.panicValue)
+		}
+		return call.result.withAge()
+	case <-ctx.Done():
+		if cached != nil {
+			return cached.withAge()
+		}
+		message := "Health check source did not return before the context was done"
+		return health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				status.TimedOutHealthCheckSourceCheckType: {
+					Type:    status.TimedOutHealthCheckSourceCheckType,
+					State:   health.New_HealthState(health.HealthState_REPAIRING),
+					Message: &message,
+				},
+			},
+		}
+	}
+}
+
+// refresh calls the wrapped source with a context detached from ctx and caches its result.
+func (t *ttlHealthCheckSource) refresh(ctx context.Context, call *ttlCall) {
+	defer func() {
+		if r := recover(); r != nil {
+			call.panicked = true
+			call.panicValue = r
+		}
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		if !call.panicked {
+			t.cached = call.result
+		}
+		t.inFlight = nil
+		close(call.done)
+	}()
+	ctx = context.WithoutCancel(ctx)
+	if t.refreshTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.refreshTimeout)
+		defer cancel()
+	}
+	healthStatus := t.source.HealthStatus(ctx)
+	call.result = &snapshot{
+		healthStatus: healthStatus,
+		time:         time.Now(),
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cached
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCheckType health.CheckType = "TEST_CHECK"
+
+type countingHealthCheckSource struct {
+	calls   int32
+	release chan struct{}
+}
+
+func (c *countingHealthCheckSource) HealthStatus(_ context.Context) health.HealthStatus {
+	calls := atomic.AddInt32(&c.calls, 1)
+	if c.release != nil {
+		<-c.release
+	}
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			testCheckType: {
+				Type:   testCheckType,
+				State:  health.New_HealthState(health.HealthState_HEALTHY),
+				Params: map[string]interface{}{"calls": calls},
+			},
+		},
+	}
+}
+
+func TestTTLHealthCheckSource_CachesUntilExpiry(t *testing.T) {
+	source := &countingHealthCheckSource{}
+	ttlSource, err := NewTTLHealthCheckSource(source, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	first := ttlSource.HealthStatus(context.Background())
+	second := ttlSource.HealthStatus(context.Background())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&source.calls))
+	assert.Equal(t, int32(1), first.Checks[testCheckType].Params["calls"])
+	assert.Equal(t, int32(1), second.Checks[testCheckType].Params["calls"])
+	assert.Contains(t, second.Checks[testCheckType].Params, CacheAgeParam)
+
+	time.Sleep(60 * time.Millisecond)
+	third := ttlSource.HealthStatus(context.Background())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&source.calls))
+	assert.Equal(t, int32(2), third.Checks[testCheckType].Params["calls"])
+}
+
+func TestTTLHealthCheckSource_CollapsesConcurrentCalls(t *testing.T) {
+	source := &countingHealthCheckSource{release: make(chan struct{})}
+	ttlSource, err := NewTTLHealthCheckSource(source, time.Minute)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	results := make([]health.HealthStatus, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = ttlSource.HealthStatus(context.Background())
+		}(i)
+	}
+	// give all callers a chance to block on the in-flight call before releasing it
+	time.Sleep(20 * time.Millisecond)
+	close(source.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&source.calls))
+	for _, result := range results {
+		assert.Equal(t, int32(1), result.Checks[testCheckType].Params["calls"])
+	}
+}
+
+type funcHealthCheckSource func(ctx context.Context) health.HealthStatus
+
+func (f funcHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
+	return f(ctx)
+}
+
+type contextHealthCheckSource struct {
+	release chan struct{}
+}
+
+func (c *contextHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
+	<-c.release
+	state := health.HealthState_HEALTHY
+	if ctx.Err() != nil {
+		state = health.HealthState_ERROR
+	}
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			testCheckType: {
+				Type:  testCheckType,
+				State: health.New_HealthState(state),
+			},
+		},
+	}
+}
+
+func TestTTLHealthCheckSource_DetachesSharedCallFromCallerContext(t *testing.T) {
+	source := &contextHealthCheckSource{release: make(chan struct{})}
+	ttlSource, err := NewTTLHealthCheckSource(source, time.Minute)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	timedOut := ttlSource.HealthStatus(ctx)
+	assert.Equal(t, health.HealthState_REPAIRING, timedOut.Checks[status.TimedOutHealthCheckSourceCheckType].State.Value())
+
+	close(source.release)
+	result := ttlSource.HealthStatus(context.Background())
+	assert.Equal(t, health.HealthState_HEALTHY, result.Checks[testCheckType].State.Value())
+}
+
+func TestTTLHealthCheckSource_WaiterHonorsContext(t *testing.T) {
+	source := &countingHealthCheckSource{}
+	ttlSource, err := NewTTLHealthCheckSource(source, 10*time.Millisecond)
+	require.NoError(t, err)
+	ttlSource.HealthStatus(context.Background())
+
+	source.release = make(chan struct{})
+	defer close(source.release)
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	stale := ttlSource.HealthStatus(ctx)
+	assert.Equal(t, int32(1), stale.Checks[testCheckType].Params["calls"])
+	assert.Contains(t, stale.Checks[testCheckType].Params, CacheAgeParam)
+}
+
+func TestTTLHealthCheckSource_RefreshTimeout(t *testing.T) {
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		<-ctx.Done()
+		return health.HealthStatus{}
+	})
+	ttlSource, err := NewTTLHealthCheckSource(source, time.Minute, WithRefreshTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatus{Checks: map[health.CheckType]health.HealthCheckResult{}}, ttlSource.HealthStatus(context.Background()))
+}
+
+func TestTTLHealthCheckSource_Panic(t *testing.T) {
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		panic("boom")
+	})
+	ttlSource, err := NewTTLHealthCheckSource(source, time.Minute)
+	require.NoError(t, err)
+	assert.PanicsWithValue(t, "boom", func() {
+		ttlSource.HealthStatus(context.Background())
+	})
+}
+
+func TestNewTTLHealthCheckSource_InvalidTTL(t *testing.T) {
+	_, err := NewTTLHealthCheckSource(&countingHealthCheckSource{}, 0)
+	assert.Error(t, err)
+}
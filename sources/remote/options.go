@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// Option is an option for a remote health check source.
+type Option func(conf *remoteSourceConfig)
+
+type remoteSourceConfig struct {
+	client           *http.Client
+	timeout          time.Duration
+	sharedSecret     string
+	checkTypePrefix  string
+	collapseChecks   bool
+	unreachableState health.HealthState_Value
+	unknownState     health.HealthState_Value
+}
+
+func defaultRemoteSourceConfig(checkType health.CheckType) remoteSourceConfig {
+	return remoteSourceConfig{
+		client:           http.DefaultClient,
+		timeout:          10 * time.Second,
+		sharedSecret:     "",
+		checkTypePrefix:  string(checkType) + "_",
+		collapseChecks:   false,
+		unreachableState: health.HealthState_ERROR,
+		unknownState:     health.HealthState_ERROR,
+	}
+}
+
+func (r *remoteSourceConfig) apply(options ...Option) {
+	for _, option := range options {
+		option(r)
+	}
+}
+
+// WithHTTPClient configures the client used to fetch the remote health status. If unset, http.DefaultClient is used.
+func WithHTTPClient(client *http.Client) Option {
+	return func(conf *remoteSourceConfig) {
+		conf.client = client
+	}
+}
+
+// WithTimeout configures the timeout of each request to the remote health endpoint. If unset, defaults to 10 seconds.
+// A non-positive timeout disables the timeout so that only the deadline of the caller's context applies.
+func WithTimeout(timeout time.Duration) Option {
+	return func(conf *remoteSourceConfig) {
+		conf.timeout = timeout
+	}
+}
+
+// WithSharedSecret configures the shared secret sent as a bearer token in the Authorization header of requests to the
+// remote health endpoint.
+func WithSharedSecret(sharedSecret string) Option {
+	return func(conf *remoteSourceConfig) {
+		conf.sharedSecret = sharedSecret
+	}
+}
+
+// WithCheckTypePrefix configures the prefix prepended to the remote check types. If unset, remote check types are
+// prefixed with the check type of the source followed by an underscore. An empty prefix reports the remote check
+// types unchanged.
+func WithCheckTypePrefix(prefix string) Option {
+	return func(conf *remoteSourceConfig) {
+		conf.checkTypePrefix = prefix
+	}
+}
+
+// WithCollapsedChecks configures the source to report the remote health status as a single check with the check type
+// of the source. The state of the check is the worst state of the remote checks and its message is taken from the
+// check that drove that state.
+func WithCollapsedChecks() Option {
+	return func(conf *remoteSourceConfig) {
+		conf.collapseChecks = true
+	}
+}
+
+// WithUnreachableState configures the state reported when the remote health status cannot be fetched or decoded.
+// If unset, defaults to health.HealthState_ERROR.
+func WithUnreachableState(state health.HealthState_Value) Option {
+	return func(conf *remoteSourceConfig) {
+		conf.unreachableState = state
+	}
+}
+
+// WithUnknownState configures the state that remote checks with a health state that is not recognized by this library
+// are mapped to. If unset, defaults to health.HealthState_ERROR.
+func WithUnknownState(state health.HealthState_Value) Option {
+	return func(conf *remoteSourceConfig) {
+		conf.unknownState = state
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/sources"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+const (
+	// URLParam is the param that holds the URL of the remote health endpoint with any user information redacted.
+	URLParam = "url"
+	// StatusCodeParam is the param that holds the response code of the remote health endpoint if the response could
+	// not be decoded.
+	StatusCodeParam = status.StatusCodeParam
+	// RemoteStateParam is the param that holds the unrecognized state reported by a remote check.
+	RemoteStateParam = "remoteState"
+	// RemoteChecksParam is the param of a collapsed check that holds the states of the remote checks by check type.
+	RemoteChecksParam = "remoteChecks"
+	// ErrorParam is the unsafe param that holds the message of the error that prevented fetching the remote health
+	// status.
+	ErrorParam = "error"
+)
+
+type remoteHealthCheckSource struct {
+	checkType   health.CheckType
+	url         string
+	redactedURL string
+	config      remoteSourceConfig
+}
+
+func MustNewHealthCheckSource(checkType health.CheckType, healthURL string, options ...Option) status.HealthCheckSource {
+	source, err := NewHealthCheckSource(checkType, healthURL, options...)
+	if err != nil {
+		panic(err)
+	}
+	return source
+}
+
+// NewHealthCheckSource returns a source that fetches the health status served by the SLS health endpoint at healthURL
+// on every call. By default, the remote checks are reported with their check types prefixed by checkType; if the
+// remote health status cannot be fetched, a single check of type checkType is reported in the unreachable state with
+// the params of the error and its message in the unsafe ErrorParam param.
+//
+// Every call to HealthStatus makes a request to the remote service, so the source should usually be wrapped in a
+// source from the cached package.
+func NewHealthCheckSource(checkType health.CheckType, healthURL string, options ...Option) (status.HealthCheckSource, error) {
+	if checkType == "" {
+		return nil, werror.Error("check type must not be empty")
+	}
+	parsedURL, err := url.Parse(healthURL)
+	if err != nil {
+		return nil, werror.Wrap(err, "invalid health endpoint URL")
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, werror.Error("health endpoint URL must use the http or https scheme",
+			werror.SafeParam("scheme", parsedURL.Scheme))
+	}
+	conf := defaultRemoteSourceConfig(checkType)
+	conf.apply(options...)
+	if conf.client == nil {
+		return nil, werror.Error("http client must not be nil")
+	}
+	return &remoteHealthCheckSource{
+		checkType:   checkType,
+		url:         healthURL,
+		redactedURL: parsedURL.Redacted(),
+		config:      conf,
+	}, nil
+}
+
+func (r *remoteHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
+	remoteStatus, params, err := r.fetch(ctx)
+	if err != nil {
+		for key, value := range sources.ParamsFromError(err) {
+			params[key] = value
+		}
+		params[ErrorParam] = status.NewUnsafeParam(err.Error())
+		message := "Failed to fetch health status of remote service"
+		return health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				r.checkType: {
+					Type:    r.checkType,
+					State:   health.New_HealthState(r.config.unreachableState),
+					Message: &message,
+					Params:  params,
+				},
+			},
+		}
+	}
+	remoteStatus = r.mapUnknownStates(remoteStatus)
+	if r.config.collapseChecks {
+		return r.collapse(remoteStatus)
+	}
+	return r.prefix(remoteStatus)
+}
+
+// fetch returns the remote health status or an error along with the params describing the failure.
+func (r *remoteHealthCheckSource) fetch(ctx context.Context) (health.HealthStatus, map[string]interface{}, error) {
+	params := map[string]interface{}{
+		URLParam: r.redactedURL,
+	}
+	if r.config.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.config.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return health.HealthStatus{}, params, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if r.config.sharedSecret != "" {
+		req.Header.Set("Authorization", "Bearer "+r.config.sharedSecret)
+	}
+	resp, err := r.config.client.Do(req)
+	if err != nil {
+		return health.HealthStatus{}, params, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	remoteStatus, err := status.DecodeHealthResponse(resp)
+	if err != nil {
+		params[StatusCodeParam] = resp.StatusCode
+		return health.HealthStatus{}, params, err
+	}
+	return remoteStatus, nil, nil
+}
+
+func (r *remoteHealthCheckSource) mapUnknownStates(remoteStatus health.HealthStatus) health.HealthStatus {
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(remoteStatus.Checks))
+	for checkType, result := range remoteStatus.Checks {
+		if result.State.IsUnknown() {
+			params := make(map[string]interface{}, len(result.Params)+1)
+			for k, v := range result.Params {
+				params[k] = v
+			}
+			params[RemoteStateParam] = result.State.String()
+			result.State = health.New_HealthState(r.config.unknownState)
+			result.Params = params
+		}
+		checks[checkType] = result
+	}
+	return health.HealthStatus{Checks: checks}
+}
+
+func (r *remoteHealthCheckSource) prefix(remoteStatus health.HealthStatus) health.HealthStatus {
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(remoteStatus.Checks))
+	for checkType, result := range remoteStatus.Checks {
+		result.Type = health.CheckType(r.config.checkTypePrefix) + checkType
+		checks[result.Type] = result
+	}
+	return health.HealthStatus{Checks: checks}
+}
+
+func (r *remoteHealthCheckSource) collapse(remoteStatus health.HealthStatus) health.HealthStatus {
+	remoteChecks := make(map[health.CheckType]health.HealthState, len(remoteStatus.Checks))
+	for checkType, result := range remoteStatus.Checks {
+		remoteChecks[checkType] = result.State
+	}
+	aggregate := status.AggregateHealthStatus(remoteStatus)
+	result := health.HealthCheckResult{
+		Type:  r.checkType,
+		State: aggregate.State,
+		Params: map[string]interface{}{
+			URLParam:          r.redactedURL,
+			RemoteChecksParam: remoteChecks,
+		},
+	}
+	if aggregate.DrivingCheck != nil && aggregate.DrivingCheck.Message != nil {
+		message := string(aggregate.DrivingCheck.Type) + ": " + *aggregate.DrivingCheck.Message
+		result.Message = &message
+	}
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			r.checkType: result,
+		},
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const remoteHealthBody = `{"checks":{
+	"DATABASE":{"type":"DATABASE","state":"ERROR","message":"connection refused","params":{}},
+	"CACHE":{"type":"CACHE","state":"HEALTHY","params":{}},
+	"FUTURE":{"type":"FUTURE","state":"SOMETHING_NEW","params":{}}
+}}`
+
+func newRemoteServer(t *testing.T, code int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHealthCheckSource(t *testing.T) {
+	server := newRemoteServer(t, 522, remoteHealthBody)
+	for _, tc := range []struct {
+		name     string
+		url      string
+		options  []Option
+		expected map[health.CheckType]health.HealthState_Value
+	}{
+		{
+			name:    "prefixes remote check types",
+			url:     server.URL,
+			options: []Option{WithSharedSecret("secret")},
+			expected: map[health.CheckType]health.HealthState_Value{
+				"REMOTE_DATABASE": health.HealthState_ERROR,
+				"REMOTE_CACHE":    health.HealthState_HEALTHY,
+				"REMOTE_FUTURE":   health.HealthState_ERROR,
+			},
+		},
+		{
+			name:    "uses custom prefix and unknown state",
+			url:     server.URL,
+			options: []Option{WithSharedSecret("secret"), WithCheckTypePrefix(""), WithUnknownState(health.HealthState_WARNING)},
+			expected: map[health.CheckType]health.HealthState_Value{
+				"DATABASE": health.HealthState_ERROR,
+				"CACHE":    health.HealthState_HEALTHY,
+				"FUTURE":   health.HealthState_WARNING,
+			},
+		},
+		{
+			name:    "collapses remote checks",
+			url:     server.URL,
+			options: []Option{WithSharedSecret("secret"), WithCollapsedChecks()},
+			expected: map[health.CheckType]health.HealthState_Value{
+				"REMOTE": health.HealthState_ERROR,
+			},
+		},
+		{
+			name:    "derives check from status code of response without body",
+			url:     newRemoteServer(t, 521, "").URL,
+			options: []Option{WithSharedSecret("secret")},
+			expected: map[health.CheckType]health.HealthState_Value{
+				"REMOTE_HEALTH_STATUS_CODE": health.HealthState_WARNING,
+			},
+		},
+		{
+			name: "reports undecodable response as unreachable",
+			url:  server.URL,
+			expected: map[health.CheckType]health.HealthState_Value{
+				"REMOTE": health.HealthState_ERROR,
+			},
+		},
+		{
+			name:    "reports successful response without health status as unreachable",
+			url:     newRemoteServer(t, http.StatusOK, "<html>login page</html>").URL,
+			options: []Option{WithSharedSecret("secret")},
+			expected: map[health.CheckType]health.HealthState_Value{
+				"REMOTE": health.HealthState_ERROR,
+			},
+		},
+		{
+			name:    "reports connection failure with configured state",
+			url:     "http://127.0.0.1:0/health",
+			options: []Option{WithUnreachableState(health.HealthState_REPAIRING)},
+			expected: map[health.CheckType]health.HealthState_Value{
+				"REMOTE": health.HealthState_REPAIRING,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			source, err := NewHealthCheckSource("REMOTE", tc.url, tc.options...)
+			require.NoError(t, err)
+			healthStatus := source.HealthStatus(context.Background())
+			actual := make(map[health.CheckType]health.HealthState_Value, len(healthStatus.Checks))
+			for checkType, result := range healthStatus.Checks {
+				assert.Equal(t, checkType, result.Type)
+				actual[checkType] = result.State.Value()
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestHealthCheckSource_Params(t *testing.T) {
+	server := newRemoteServer(t, 522, remoteHealthBody)
+
+	source := MustNewHealthCheckSource("REMOTE", server.URL, WithSharedSecret("secret"), WithCollapsedChecks())
+	result := source.HealthStatus(context.Background()).Checks["REMOTE"]
+	require.NotNil(t, result.Message)
+	assert.Equal(t, "DATABASE: connection refused", *result.Message)
+	assert.Equal(t, server.URL, result.Params[URLParam])
+	assert.Len(t, result.Params[RemoteChecksParam], 3)
+
+	source = MustNewHealthCheckSource("REMOTE", server.URL, WithSharedSecret("secret"))
+	assert.Equal(t, "SOMETHING_NEW", source.HealthStatus(context.Background()).Checks["REMOTE_FUTURE"].Params[RemoteStateParam])
+
+	source = MustNewHealthCheckSource("REMOTE", server.URL)
+	assert.Equal(t, map[string]interface{}{
+		URLParam:        server.URL,
+		StatusCodeParam: http.StatusUnauthorized,
+		ErrorParam:      status.NewUnsafeParam("failed to decode health response: EOF"),
+	}, source.HealthStatus(context.Background()).Checks["REMOTE"].Params)
+}
+
+func TestHealthCheckSource_Timeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	source := MustNewHealthCheckSource("REMOTE", server.URL, WithTimeout(10*time.Millisecond))
+	start := time.Now()
+	result := source.HealthStatus(context.Background()).Checks["REMOTE"]
+	assert.Equal(t, health.HealthState_ERROR, result.State.Value())
+	assert.Less(t, time.Since(start), time.Second)
+	errorParam, ok := result.Params[ErrorParam].(status.UnsafeParam)
+	require.True(t, ok)
+	assert.Contains(t, errorParam.Value, context.DeadlineExceeded.Error())
+}
+
+func TestNewHealthCheckSource_Invalid(t *testing.T) {
+	_, err := NewHealthCheckSource("", "http://localhost/health")
+	assert.Error(t, err)
+	_, err = NewHealthCheckSource("REMOTE", "localhost/health")
+	assert.Error(t, err)
+	_, err = NewHealthCheckSource("REMOTE", "http://localhost/health", WithHTTPClient(nil))
+	assert.Error(t, err)
+}
@@ -39,7 +39,8 @@ type KeyedErrorSubmitter interface {
 // KeyedErrorHealthCheckSource tracks errors by key to compute health status. Only entries with non-nil
 // errors are stored. When computing health status, the KeyedErrorHealthCheckSource will return a
 // health status with state HealthStateHealthy if it has no error entries. If it has any error entries, it will return
-// a health status with the state set to HealthStateError and params including all errors by their keys.
+// a health status with the state set to HealthStateError and params including all errors by their keys. Error
+// messages and unsafe error params are wrapped using status.NewUnsafeParam.
 type KeyedErrorHealthCheckSource interface {
 	KeyedErrorSubmitter
 	status.HealthCheckSource
@@ -87,8 +88,8 @@ func (k *keyedErrorHealthCheckSource) HealthStatus(ctx context.Context) health.H
 	}
 	params := map[string]interface{}{}
 	for key, err := range k.keyedErrors {
-		params[key] = err.Error()
-		for k, v := range sources.SafeParamsFromError(err) {
+		params[key] = status.NewUnsafeParam(err.Error())
+		for k, v := range sources.ParamsFromError(err) {
 			params[fmt.Sprintf("%s-%s", key, k)] = v
 		}
 	}
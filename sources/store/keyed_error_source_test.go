@@ -21,6 +21,7 @@ import (
 
 	werror "github.com/palantir/witchcraft-go-error"
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,8 +38,8 @@ func TestKeyedMessengerHealthStateError(t *testing.T) {
 			"TEST": {
 				Message: &testMessage,
 				Params: map[string]interface{}{
-					"1":     "error message 1",
-					"2":     "error message 2",
+					"1":     status.NewUnsafeParam("error message 1"),
+					"2":     status.NewUnsafeParam("error message 2"),
 					"1-foo": "baz",
 					"2-foo": "bar",
 				},
@@ -74,8 +75,8 @@ func TestKeyedMessengerHealthStateErrorAndPreserve(t *testing.T) {
 			"TEST": {
 				Message: &testMessage,
 				Params: map[string]interface{}{
-					"1": "error message 1",
-					"2": "error message 2",
+					"1": status.NewUnsafeParam("error message 1"),
+					"2": status.NewUnsafeParam("error message 2"),
 				},
 				State: health.New_HealthState(health.HealthState_ERROR),
 				Type:  "TEST",
@@ -91,7 +92,7 @@ func TestKeyedMessengerHealthStateErrorAndPreserve(t *testing.T) {
 			"TEST": {
 				Message: &testMessage,
 				Params: map[string]interface{}{
-					"2": "error message 2",
+					"2": status.NewUnsafeParam("error message 2"),
 				},
 				State: health.New_HealthState(health.HealthState_ERROR),
 				Type:  "TEST",
@@ -112,3 +113,13 @@ func TestKeyedMessengerHealthStateErrorAndPreserve(t *testing.T) {
 		},
 	}, keyedErrorSource.HealthStatus(context.Background()))
 }
+
+func TestKeyedMessengerHealthStateErrorUnsafeParams(t *testing.T) {
+	keyedErrorSource := NewKeyedErrorHealthCheckSource("TEST", testMessage)
+	keyedErrorSource.Submit("1", werror.Error("error message 1", werror.SafeParam("foo", "baz"), werror.UnsafeParam("user", "alice")))
+	assert.Equal(t, map[string]interface{}{
+		"1":      status.NewUnsafeParam("error message 1"),
+		"1-foo":  "baz",
+		"1-user": status.NewUnsafeParam("alice"),
+	}, keyedErrorSource.HealthStatus(context.Background()).Checks["TEST"].Params)
+}
@@ -29,9 +29,10 @@ type (
 )
 
 type healthCheckSourceTreeNode struct {
-	healthCheckSource                 status.HealthCheckSource
-	combinedChildrenHealthCheckSource status.HealthCheckSource
-	traverseForHealthState            TraverseForHealthStatus
+	healthCheckSource          status.HealthCheckSource
+	childrenHealthCheckSources []status.HealthCheckSource
+	traverseForHealthState     TraverseForHealthStatus
+	conflictPolicy             status.ConflictPolicy
 }
 
 type CheckSourceTreeParam interface {
@@ -52,6 +53,16 @@ func WithTraverseForHealthStatus(fn TraverseForHealthStatus) CheckSourceTreePara
 	})
 }
 
+// WithConflictPolicy sets the policy used when the node and its children, or multiple children, report the same check
+// type. The node's own source is named for the purposes of conflict resolution as if it were at index 0 and the
+// children as if they were at the following indices, unless they are status.NamedHealthCheckSource values. The
+// default policy is status.LastSourceWins.
+func WithConflictPolicy(policy status.ConflictPolicy) CheckSourceTreeParam {
+	return healthCheckSourceTreeParamFn(func(node *healthCheckSourceTreeNode) {
+		node.conflictPolicy = policy
+	})
+}
+
 // NewHealthCheckSourceTree returns a new health check source tree node that uses the result of its own health check
 // to determine if it should invoke the provided child health checks. The default behavior is to only traverse to child
 // checks if the most severe health state of the current node's health status is health.HealthState_HEALTHY.
@@ -61,9 +72,10 @@ func NewHealthCheckSourceTree(
 	params ...CheckSourceTreeParam,
 ) status.HealthCheckSource {
 	n := &healthCheckSourceTreeNode{
-		healthCheckSource:                 ownHealthCheckSource,
-		combinedChildrenHealthCheckSource: status.NewCombinedHealthCheckSource(childrenHealthCheckSources...),
-		traverseForHealthState:            defaultTraverseForHealthStatus,
+		healthCheckSource:          ownHealthCheckSource,
+		childrenHealthCheckSources: childrenHealthCheckSources,
+		traverseForHealthState:     defaultTraverseForHealthStatus,
+		conflictPolicy:             status.LastSourceWins,
 	}
 	for _, param := range params {
 		param.apply(n)
@@ -76,24 +88,19 @@ func (n *healthCheckSourceTreeNode) HealthStatus(ctx context.Context) health.Hea
 	if !n.traverseForHealthState(ownHealthStatus) {
 		return ownHealthStatus
 	}
-	healthStatusFromChildSources := n.combinedChildrenHealthCheckSource.HealthStatus(ctx)
-	for checkType, checkResult := range healthStatusFromChildSources.Checks {
-		ownHealthStatus.Checks[checkType] = checkResult
-	}
-	return ownHealthStatus
-}
-
-func healthStateFromChecks(checks map[health.CheckType]health.HealthCheckResult) health.HealthState {
-	healthState := health.New_HealthState(health.HealthState_HEALTHY)
-	for _, checkResult := range checks {
-		code := status.HealthStateStatusCode(checkResult.State.Value())
-		if code > status.HealthStateStatusCode(healthState.Value()) {
-			healthState = checkResult.State
+	statuses := []status.NamedHealthStatus{{
+		Name:         status.HealthCheckSourceName(n.healthCheckSource, 0),
+		HealthStatus: ownHealthStatus,
+	}}
+	for idx, childHealthCheckSource := range n.childrenHealthCheckSources {
+		if childHealthCheckSource == nil {
+			continue
 		}
+		statuses = append(statuses, status.NewNamedHealthStatus(ctx, childHealthCheckSource, idx+1))
 	}
-	return healthState
+	return status.MergeHealthStatuses(n.conflictPolicy, statuses...)
 }
 
 func defaultTraverseForHealthStatus(healthStatus health.HealthStatus) bool {
-	return healthStateFromChecks(healthStatus.Checks).Value() == health.HealthState_HEALTHY
+	return status.WorstHealthState(healthStatus).Value() == health.HealthState_HEALTHY
 }
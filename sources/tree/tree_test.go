@@ -125,3 +125,41 @@ func TestHealthCheckSourceTreeWithTraverseForHealthState(t *testing.T) {
 		},
 	}, healthCheckSourceTree.HealthStatus(context.Background()))
 }
+
+func TestHealthCheckSourceTreeWithConflictPolicy(t *testing.T) {
+	parentCheck := status.NewNamedHealthCheckSource("PARENT", healthStatusFn(func(ctx context.Context) health.HealthStatus {
+		return health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				"SHARED_CHECK": {
+					Type:  "SHARED_CHECK",
+					State: health.New_HealthState(health.HealthState_HEALTHY),
+				},
+			},
+		}
+	}))
+	childCheck := status.NewNamedHealthCheckSource("CHILD", healthStatusFn(func(ctx context.Context) health.HealthStatus {
+		return health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				"SHARED_CHECK": {
+					Type:  "SHARED_CHECK",
+					State: health.New_HealthState(health.HealthState_WARNING),
+				},
+			},
+		}
+	}))
+	healthCheckSourceTree := tree.NewHealthCheckSourceTree(parentCheck, []status.HealthCheckSource{
+		childCheck,
+	}, tree.WithConflictPolicy(status.NamespaceConflicts))
+	assert.Equal(t, health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"PARENT_SHARED_CHECK": {
+				Type:  "PARENT_SHARED_CHECK",
+				State: health.New_HealthState(health.HealthState_HEALTHY),
+			},
+			"CHILD_SHARED_CHECK": {
+				Type:  "CHILD_SHARED_CHECK",
+				State: health.New_HealthState(health.HealthState_WARNING),
+			},
+		},
+	}, healthCheckSourceTree.HealthStatus(context.Background()))
+}
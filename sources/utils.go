@@ -17,9 +17,11 @@ package sources
 import (
 	werror "github.com/palantir/witchcraft-go-error"
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
 )
 
 // UnhealthyHealthCheckResult returns an unhealthy health check result with type checkType and message message.
+// Params that may contain sensitive information should be wrapped using status.NewUnsafeParam.
 func UnhealthyHealthCheckResult(checkType health.CheckType, message string, params map[string]interface{}) health.HealthCheckResult {
 	return health.HealthCheckResult{
 		Type:    checkType,
@@ -62,3 +64,18 @@ func SafeParamsFromError(err error) map[string]interface{} {
 	safeParams, _ := werror.ParamsFromError(err)
 	return safeParams
 }
+
+// ParamsFromError returns the params of the given error. Safe params are returned as-is and unsafe params are wrapped
+// using status.NewUnsafeParam so that they can be redacted before the health status leaves the process. Safe params
+// take precedence over unsafe params with the same key.
+func ParamsFromError(err error) map[string]interface{} {
+	safeParams, unsafeParams := werror.ParamsFromError(err)
+	params := make(map[string]interface{}, len(safeParams)+len(unsafeParams))
+	for key, value := range unsafeParams {
+		params[key] = status.NewUnsafeParam(value)
+	}
+	for key, value := range safeParams {
+		params[key] = value
+	}
+	return params
+}
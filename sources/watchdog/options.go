@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+const defaultErrorThreshold = 2
+
+// Option is an option for a watchdog health check source.
+type Option func(conf *watchdogSourceConfig)
+
+type watchdogSourceConfig struct {
+	errorThreshold float64
+	overdueState   health.HealthState_Value
+	stuckState     health.HealthState_Value
+}
+
+func defaultWatchdogSourceConfig() watchdogSourceConfig {
+	return watchdogSourceConfig{
+		errorThreshold: defaultErrorThreshold,
+		overdueState:   health.HealthState_WARNING,
+		stuckState:     health.HealthState_ERROR,
+	}
+}
+
+func (w *watchdogSourceConfig) apply(options ...Option) {
+	for _, option := range options {
+		option(w)
+	}
+}
+
+// WithErrorThreshold configures the multiple of its expected duration after which an overdue operation is considered
+// stuck rather than overdue. Must be at least 1. If unset, operations are stuck after twice their expected duration.
+func WithErrorThreshold(multiple float64) Option {
+	return func(conf *watchdogSourceConfig) {
+		conf.errorThreshold = multiple
+	}
+}
+
+// WithHealthStates configures the states reported while any operation is overdue and while any operation is stuck.
+// If unset, overdue operations are reported as WARNING and stuck operations as ERROR.
+func WithHealthStates(overdueState, stuckState health.HealthState_Value) Option {
+	return func(conf *watchdogSourceConfig) {
+		conf.overdueState = overdueState
+		conf.stuckState = stuckState
+	}
+}
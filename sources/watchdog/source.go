@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+// OverdueOperationsParam is the param that lists the overdue operations of an unhealthy watchdog check, starting with
+// the oldest operation.
+const OverdueOperationsParam = "overdueOperations"
+
+// HealthCheckSource is a thread-safe HealthCheckSource that watches for long-running operations that never complete.
+// Operations are started using Begin and completed by calling End on the returned Operation.
+// While every in-flight operation is younger than its expected duration, returns healthy. An operation that exceeds
+// its expected duration is overdue and makes the check WARNING, and an operation that exceeds its expected duration
+// by the configured error threshold is stuck and makes the check ERROR.
+type HealthCheckSource struct {
+	checkType      health.CheckType
+	errorThreshold float64
+	overdueState   health.HealthState_Value
+	stuckState     health.HealthState_Value
+	now            func() time.Time
+
+	// mutex protects access to `operations` and `nextID`.
+	mutex      sync.Mutex
+	operations map[uint64]*Operation
+	nextID     uint64
+}
+
+var _ status.HealthCheckSource = &HealthCheckSource{}
+
+// Operation is an in-flight operation of a HealthCheckSource.
+type Operation struct {
+	source    *HealthCheckSource
+	id        uint64
+	name      string
+	expected  time.Duration
+	started   time.Time
+	goroutine string
+	caller    string
+}
+
+// MustNewHealthCheckSource creates a HealthCheckSource with the specified set of Option modifiers. The returning
+// HealthCheckResult is of type checkType. Panics if inputs are invalid.
+// Should only be used in instances where the inputs are statically defined and known to be valid.
+func MustNewHealthCheckSource(checkType health.CheckType, options ...Option) *HealthCheckSource {
+	healthCheckSource, err := NewHealthCheckSource(checkType, options...)
+	if err != nil {
+		panic(err)
+	}
+	return healthCheckSource
+}
+
+// NewHealthCheckSource creates a HealthCheckSource with the specified set of Option modifiers. The returning
+// HealthCheckResult is of type checkType. Returns an error if any inputs are invalid.
+func NewHealthCheckSource(checkType health.CheckType, options ...Option) (*HealthCheckSource, error) {
+	conf := defaultWatchdogSourceConfig()
+	conf.apply(options...)
+
+	if conf.errorThreshold < 1 {
+		return nil, werror.Error("errorThreshold must be at least 1",
+			werror.SafeParam("errorThreshold", conf.errorThreshold))
+	}
+	return &HealthCheckSource{
+		checkType:      checkType,
+		errorThreshold: conf.errorThreshold,
+		overdueState:   conf.overdueState,
+		stuckState:     conf.stuckState,
+		now:            time.Now,
+		operations:     make(map[uint64]*Operation),
+	}, nil
+}
+
+// Begin starts watching an operation that is expected to complete within expected and returns the Operation, which
+// must be ended by calling End once the operation completes. The goroutine and the caller of Begin are recorded so
+// that stuck operations can be traced back to their origin. Operations with a non-positive expected duration are
+// never overdue. The name should not contain sensitive information since it is reported as a safe param.
+func (h *HealthCheckSource) Begin(name string, expected time.Duration) *Operation {
+	operation := &Operation{
+		source:    h,
+		name:      name,
+		expected:  expected,
+		goroutine: goroutineID(),
+		caller:    caller(1),
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	operation.started = h.now()
+	operation.id = h.nextID
+	h.nextID++
+	h.operations[operation.id] = operation
+	return operation
+}
+
+// End stops watching the operation. Calling End more than once has no effect.
+func (o *Operation) End() {
+	o.source.mutex.Lock()
+	defer o.source.mutex.Unlock()
+	delete(o.source.operations, o.id)
+}
+
+// HealthStatus returns unhealthy if any in-flight operation is overdue, listing the overdue operations in the params
+// of the result. Otherwise, returns healthy.
+func (h *HealthCheckSource) HealthStatus(_ context.Context) health.HealthStatus {
+	h.mutex.Lock()
+	curTime := h.now()
+	var overdue []*Operation
+	for _, operation := range h.operations {
+		if operation.expected > 0 && curTime.Sub(operation.started) > operation.expected {
+			overdue = append(overdue, operation)
+		}
+	}
+	h.mutex.Unlock()
+
+	if len(overdue) == 0 {
+		return health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				h.checkType: {
+					Type:  h.checkType,
+					State: health.New_HealthState(health.HealthState_HEALTHY),
+				},
+			},
+		}
+	}
+
+	sort.Slice(overdue, func(i, j int) bool {
+		if !overdue[i].started.Equal(overdue[j].started) {
+			return overdue[i].started.Before(overdue[j].started)
+		}
+		return overdue[i].id < overdue[j].id
+	})
+	state := h.overdueState
+	operations := make([]map[string]interface{}, 0, len(overdue))
+	for _, operation := range overdue {
+		age := curTime.Sub(operation.started)
+		if float64(age) >= float64(operation.expected)*h.errorThreshold {
+			state = h.stuckState
+		}
+		operations = append(operations, map[string]interface{}{
+			"name":      operation.name,
+			"age":       age.String(),
+			"expected":  operation.expected.String(),
+			"goroutine": operation.goroutine,
+			"caller":    operation.caller,
+		})
+	}
+	message := fmt.Sprintf("%d operations are overdue", len(overdue))
+	if len(overdue) == 1 {
+		message = fmt.Sprintf("Operation %s is overdue", overdue[0].name)
+	}
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			h.checkType: {
+				Type:    h.checkType,
+				State:   health.New_HealthState(state),
+				Message: &message,
+				Params: map[string]interface{}{
+					OverdueOperationsParam: operations,
+				},
+			},
+		},
+	}
+}
+
+// goroutineID returns the ID of the current goroutine, which is not exposed by the runtime other than in stack traces
+// that start with "goroutine <id> [<status>]:".
+func goroutineID() string {
+	var buf [64]byte
+	stack := bytes.TrimPrefix(buf[:runtime.Stack(buf[:], false)], []byte("goroutine "))
+	if i := bytes.IndexByte(stack, ' '); i > 0 {
+		if _, err := strconv.ParseUint(string(stack[:i]), 10, 64); err == nil {
+			return string(stack[:i])
+		}
+	}
+	return "unknown"
+}
+
+// caller returns the function and location of the caller skip frames above the caller of caller.
+func caller(skip int) string {
+	pc, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "unknown"
+	}
+	location := fmt.Sprintf("%s:%d", file, line)
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		return fn.Name() + " (" + location + ")"
+	}
+	return location
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCheckType = "TEST_CHECK"
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func newTestSource(t *testing.T, options ...Option) (*HealthCheckSource, *testClock) {
+	source, err := NewHealthCheckSource(testCheckType, options...)
+	require.NoError(t, err)
+	clock := &testClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	source.now = clock.Now
+	return source, clock
+}
+
+func checkResult(t *testing.T, source *HealthCheckSource) health.HealthCheckResult {
+	result, ok := source.HealthStatus(context.Background()).Checks[testCheckType]
+	require.True(t, ok)
+	return result
+}
+
+func TestHealthCheckSource(t *testing.T) {
+	source, clock := newTestSource(t)
+	assert.Equal(t, health.HealthState_HEALTHY, checkResult(t, source).State.Value())
+
+	migration := source.Begin("migration", time.Minute)
+	clock.now = clock.now.Add(30 * time.Second)
+	compaction := source.Begin("compaction", time.Minute)
+	handoff := source.Begin("handoff", 0)
+	assert.Equal(t, health.HealthState_HEALTHY, checkResult(t, source).State.Value())
+
+	clock.now = clock.now.Add(45 * time.Second)
+	result := checkResult(t, source)
+	assert.Equal(t, health.HealthState_WARNING, result.State.Value())
+	assert.Equal(t, "Operation migration is overdue", *result.Message)
+	operations := result.Params[OverdueOperationsParam].([]map[string]interface{})
+	require.Len(t, operations, 1)
+	assert.Equal(t, "migration", operations[0]["name"])
+	assert.Equal(t, "1m15s", operations[0]["age"])
+	assert.Equal(t, "1m0s", operations[0]["expected"])
+	assert.Regexp(t, `^[0-9]+$`, operations[0]["goroutine"])
+	assert.True(t, strings.HasPrefix(operations[0]["caller"].(string), "github.com/palantir/witchcraft-go-health/sources/watchdog.TestHealthCheckSource ("), operations[0]["caller"])
+
+	clock.now = clock.now.Add(time.Minute)
+	result = checkResult(t, source)
+	assert.Equal(t, health.HealthState_ERROR, result.State.Value())
+	assert.Equal(t, "2 operations are overdue", *result.Message)
+	operations = result.Params[OverdueOperationsParam].([]map[string]interface{})
+	require.Len(t, operations, 2)
+	assert.Equal(t, "migration", operations[0]["name"])
+	assert.Equal(t, "compaction", operations[1]["name"])
+
+	migration.End()
+	migration.End()
+	assert.Equal(t, health.HealthState_WARNING, checkResult(t, source).State.Value())
+	compaction.End()
+	handoff.End()
+	assert.Equal(t, health.HealthState_HEALTHY, checkResult(t, source).State.Value())
+}
+
+func TestHealthCheckSourceOptions(t *testing.T) {
+	source, clock := newTestSource(t, WithErrorThreshold(3), WithHealthStates(health.HealthState_REPAIRING, health.HealthState_TERMINAL))
+	source.Begin("operation", time.Minute)
+	clock.now = clock.now.Add(2 * time.Minute)
+	assert.Equal(t, health.HealthState_REPAIRING, checkResult(t, source).State.Value())
+	clock.now = clock.now.Add(time.Minute)
+	assert.Equal(t, health.HealthState_TERMINAL, checkResult(t, source).State.Value())
+}
+
+func TestNewHealthCheckSourceInvalidErrorThreshold(t *testing.T) {
+	_, err := NewHealthCheckSource(testCheckType, WithErrorThreshold(0.5))
+	assert.Error(t, err)
+	assert.Panics(t, func() {
+		MustNewHealthCheckSource(testCheckType, WithErrorThreshold(0.5))
+	})
+}
+
+func TestGoroutineID(t *testing.T) {
+	ids := make(chan string)
+	go func() {
+		ids <- goroutineID()
+	}()
+	assert.NotEqual(t, goroutineID(), <-ids)
+}
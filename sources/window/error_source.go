@@ -173,7 +173,7 @@ func (e *errorHealthCheckSource) HealthStatus(ctx context.Context) health.Health
 
 func (e *errorHealthCheckSource) getFailureResult(err error) health.HealthCheckResult {
 	params := map[string]interface{}{
-		"error": err.Error(),
+		"error": status.NewUnsafeParam(err.Error()),
 	}
 	healthCheckResult := health.HealthCheckResult{
 		Type:    e.checkType,
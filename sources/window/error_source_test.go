@@ -22,6 +22,7 @@ import (
 	werror "github.com/palantir/witchcraft-go-error"
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
 	"github.com/palantir/witchcraft-go-health/sources"
+	"github.com/palantir/witchcraft-go-health/status"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -63,7 +64,7 @@ func TestUnhealthyIfAtLeastOneErrorSource(t *testing.T) {
 				nil,
 			},
 			expectedCheck: sources.UnhealthyHealthCheckResult(testCheckType, checkMessage, map[string]interface{}{
-				"error": "Error #2",
+				"error": status.NewUnsafeParam("Error #2"),
 			}),
 		},
 		{
@@ -137,7 +138,7 @@ func TestHealthyIfNotAllErrorsSource(t *testing.T) {
 				werror.ErrorWithContextParams(context.Background(), "Error #2", werror.SafeParam("foo", "bar")),
 			},
 			expectedCheck: sources.UnhealthyHealthCheckResult(testCheckType, checkMessage, map[string]interface{}{
-				"error": "Error #2",
+				"error": status.NewUnsafeParam("Error #2"),
 			}),
 		},
 		{
@@ -391,7 +392,7 @@ func TestHealthyIfNoRecentErrorsSource(t *testing.T) {
 				werror.ErrorWithContextParams(context.Background(), "Error #2", werror.SafeParam("foo", "bar")),
 			},
 			expectedCheck: sources.UnhealthyHealthCheckResult(testCheckType, checkMessage, map[string]interface{}{
-				"error": "Error #2",
+				"error": status.NewUnsafeParam("Error #2"),
 			}),
 		},
 		{
@@ -438,7 +439,7 @@ func TestHealthyIfAtLeastOneSuccessSource(t *testing.T) {
 			name:   "unhealthy when there are no items",
 			errors: nil,
 			expectedCheck: sources.RepairingHealthCheckResult(testCheckType, checkMessage, map[string]interface{}{
-				"error": "no successful results within window",
+				"error": status.NewUnsafeParam("no successful results within window"),
 			}),
 		},
 		{
@@ -448,7 +449,7 @@ func TestHealthyIfAtLeastOneSuccessSource(t *testing.T) {
 			},
 			timeSinceLastSubmission: 70 * time.Minute,
 			expectedCheck: sources.RepairingHealthCheckResult(testCheckType, checkMessage, map[string]interface{}{
-				"error": "no successful results within window",
+				"error": status.NewUnsafeParam("no successful results within window"),
 			}),
 		},
 		{
@@ -458,7 +459,7 @@ func TestHealthyIfAtLeastOneSuccessSource(t *testing.T) {
 				werror.ErrorWithContextParams(context.Background(), "Error #2", werror.SafeParam("foo", "bar")),
 			},
 			expectedCheck: sources.UnhealthyHealthCheckResult(testCheckType, checkMessage, map[string]interface{}{
-				"error": "Error #2",
+				"error": status.NewUnsafeParam("Error #2"),
 			}),
 		},
 		{
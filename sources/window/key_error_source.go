@@ -158,7 +158,7 @@ func (k *keyedErrorHealthCheckSource) HealthStatus(ctx context.Context) health.H
 		}
 
 		shouldError = shouldError || k.shouldError(errItem)
-		params[errItem.Key] = errItem.Payload.(error).Error()
+		params[errItem.Key] = status.NewUnsafeParam(errItem.Payload.(error).Error())
 	}
 
 	if len(params) > 0 {
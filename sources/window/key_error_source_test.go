@@ -22,6 +22,7 @@ import (
 	werror "github.com/palantir/witchcraft-go-error"
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
 	"github.com/palantir/witchcraft-go-health/sources"
+	"github.com/palantir/witchcraft-go-health/status"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -68,8 +69,8 @@ func TestKeyedUnhealthyIfAtLeastOneErrorSource(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #1 for key 1",
-					"2": "Error #1 for key 2",
+					"1": status.NewUnsafeParam("Error #1 for key 1"),
+					"2": status.NewUnsafeParam("Error #1 for key 2"),
 				},
 			},
 		},
@@ -86,9 +87,9 @@ func TestKeyedUnhealthyIfAtLeastOneErrorSource(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #1 for key 1",
-					"2": "Error #2 for key 2",
-					"3": "Error #1 for key 3",
+					"1": status.NewUnsafeParam("Error #1 for key 1"),
+					"2": status.NewUnsafeParam("Error #2 for key 2"),
+					"3": status.NewUnsafeParam("Error #1 for key 3"),
 				},
 			},
 		},
@@ -162,8 +163,8 @@ func TestKeyedHealthyIfNotAllErrorsSource_OutsideStartWindow(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #1 for key 1",
-					"2": "Error #2 for key 2",
+					"1": status.NewUnsafeParam("Error #1 for key 1"),
+					"2": status.NewUnsafeParam("Error #2 for key 2"),
 				},
 			},
 		},
@@ -180,9 +181,9 @@ func TestKeyedHealthyIfNotAllErrorsSource_OutsideStartWindow(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #1 for key 1",
-					"2": "Error #2 for key 2",
-					"3": "Error #1 for key 3",
+					"1": status.NewUnsafeParam("Error #1 for key 1"),
+					"2": status.NewUnsafeParam("Error #2 for key 2"),
+					"3": status.NewUnsafeParam("Error #1 for key 3"),
 				},
 			},
 		},
@@ -248,8 +249,8 @@ func TestKeyedHealthyIfNotAllErrorsSource_InsideStartWindow(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #1 for key 1",
-					"2": "Error #2 for key 2",
+					"1": status.NewUnsafeParam("Error #1 for key 1"),
+					"2": status.NewUnsafeParam("Error #2 for key 2"),
 				},
 			},
 		},
@@ -266,9 +267,9 @@ func TestKeyedHealthyIfNotAllErrorsSource_InsideStartWindow(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #1 for key 1",
-					"2": "Error #2 for key 2",
-					"3": "Error #1 for key 3",
+					"1": status.NewUnsafeParam("Error #1 for key 1"),
+					"2": status.NewUnsafeParam("Error #2 for key 2"),
+					"3": status.NewUnsafeParam("Error #1 for key 3"),
 				},
 			},
 		},
@@ -320,7 +321,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_InitialWindowErrorsReturnRepairing(t *
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -336,7 +337,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_InitialWindowErrorsReturnRepairing(t *
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -370,7 +371,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -386,8 +387,8 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
-					"2": "error for key: 2",
+					"1": status.NewUnsafeParam("error for key: 1"),
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -402,7 +403,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"2": "error for key: 2",
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -444,7 +445,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -459,8 +460,8 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
-					"2": "error for key: 2",
+					"1": status.NewUnsafeParam("error for key: 1"),
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -475,7 +476,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"2": "error for key: 2",
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -517,7 +518,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -533,8 +534,8 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
-					"2": "error for key: 2",
+					"1": status.NewUnsafeParam("error for key: 1"),
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -551,8 +552,8 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
-					"2": "error for key: 2",
+					"1": status.NewUnsafeParam("error for key: 1"),
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -567,7 +568,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_RepairingGracePeriod_GapThenRepairingT
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -597,7 +598,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_MaximumErrorAge(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -612,7 +613,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_MaximumErrorAge(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
+					"1": status.NewUnsafeParam("error for key: 1"),
 				},
 			},
 		},
@@ -627,8 +628,8 @@ func TestKeyedHealthyIfNotAllErrorsSource_MaximumErrorAge(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "error for key: 1",
-					"2": "error for key: 2",
+					"1": status.NewUnsafeParam("error for key: 1"),
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -643,7 +644,7 @@ func TestKeyedHealthyIfNotAllErrorsSource_MaximumErrorAge(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_REPAIRING),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"2": "error for key: 2",
+					"2": status.NewUnsafeParam("error for key: 2"),
 				},
 			},
 		},
@@ -687,7 +688,7 @@ func TestKeyedUnhealthyIfNoRecentErrorsSource(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"2": "Error #1 for key 2",
+					"2": status.NewUnsafeParam("Error #1 for key 2"),
 				},
 			},
 		},
@@ -710,7 +711,7 @@ func TestKeyedUnhealthyIfNoRecentErrorsSource(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #2 for key 1",
+					"1": status.NewUnsafeParam("Error #2 for key 1"),
 				},
 			},
 		},
@@ -739,9 +740,9 @@ func TestKeyedUnhealthyIfNoRecentErrorsSource(t *testing.T) {
 				State:   health.New_HealthState(health.HealthState_ERROR),
 				Message: &checkMessage,
 				Params: map[string]interface{}{
-					"1": "Error #1 for key 1",
-					"2": "Error #2 for key 2",
-					"3": "Error #1 for key 3",
+					"1": status.NewUnsafeParam("Error #1 for key 1"),
+					"2": status.NewUnsafeParam("Error #2 for key 2"),
+					"3": status.NewUnsafeParam("Error #1 for key 3"),
 				},
 			},
 		},
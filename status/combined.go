@@ -0,0 +1,312 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+const (
+	// TimedOutHealthCheckSourceCheckType is reported by a parallel combined source when a source that has never
+	// returned a result times out.
+	TimedOutHealthCheckSourceCheckType health.CheckType = "HEALTH_CHECK_SOURCE_TIMEOUT"
+	// PanickedHealthCheckSourceCheckType is reported by a parallel combined source when a source that has never
+	// returned a result panics.
+	PanickedHealthCheckSourceCheckType health.CheckType = "HEALTH_CHECK_SOURCE_PANIC"
+
+	timeoutParam = "healthCheckTimeout"
+	panicParam   = "healthCheckPanic"
+)
+
+type combinedHealthCheckSource struct {
+	healthCheckSources []HealthCheckSource
+	config             combinedHealthCheckSourceConfig
+
+	// mutex protects access to `lastStatuses` and `inFlight`.
+	mutex sync.RWMutex
+	// lastStatuses stores the last status returned by each source when sources are called in parallel.
+	lastStatuses map[int]health.HealthStatus
+	// inFlight stores the calls of sources that have not returned yet when sources are called in parallel.
+	inFlight map[int]*sourceCall
+}
+
+// NewCombinedHealthCheckSource returns a source that reports the checks of all provided sources. Sources are called
+// sequentially and, if multiple sources report the same check type, the result of the later source is used.
+// Use NewCombinedHealthCheckSourceWithOptions to configure this behavior.
+func NewCombinedHealthCheckSource(healthCheckSources ...HealthCheckSource) HealthCheckSource {
+	return NewCombinedHealthCheckSourceWithOptions(healthCheckSources)
+}
+
+// NewCombinedHealthCheckSourceWithOptions returns a source that reports the checks of all provided sources, configured
+// by the provided options.
+func NewCombinedHealthCheckSourceWithOptions(healthCheckSources []HealthCheckSource, options ...CombinedOption) HealthCheckSource {
+	conf := defaultCombinedHealthCheckSourceConfig()
+	conf.apply(options...)
+	return &combinedHealthCheckSource{
+		healthCheckSources: healthCheckSources,
+		config:             conf,
+		lastStatuses:       make(map[int]health.HealthStatus),
+		inFlight:           make(map[int]*sourceCall),
+	}
+}
+
+func (c *combinedHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
+	var statuses []NamedHealthStatus
+	if c.config.parallel {
+		statuses = c.parallelHealthStatuses(ctx)
+	} else {
+		statuses = c.sequentialHealthStatuses(ctx)
+	}
+	healthStatus := MergeHealthStatuses(c.config.conflictPolicy, statuses...)
+	if c.config.redactionPolicy == "" {
+		return healthStatus
+	}
+	return RedactHealthStatus(c.config.redactionPolicy, healthStatus)
+}
+
+func (c *combinedHealthCheckSource) sequentialHealthStatuses(ctx context.Context) []NamedHealthStatus {
+	statuses := make([]NamedHealthStatus, 0, len(c.healthCheckSources))
+	for idx, healthCheckSource := range c.healthCheckSources {
+		if healthCheckSource == nil {
+			continue
+		}
+		statuses = append(statuses, NewNamedHealthStatus(ctx, healthCheckSource, idx))
+	}
+	return statuses
+}
+
+type sourceOutcome struct {
+	healthStatus health.HealthStatus
+	panicked     bool
+	panicValue   interface{}
+}
+
+// sourceCall is a call of a source whose outcome is set before done is closed.
+type sourceCall struct {
+	done    chan struct{}
+	outcome sourceOutcome
+}
+
+func (c *combinedHealthCheckSource) parallelHealthStatuses(ctx context.Context) []NamedHealthStatus {
+	if c.config.perSourceTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.perSourceTimeout)
+		defer cancel()
+	}
+
+	calls := make(map[int]*sourceCall, len(c.healthCheckSources))
+	for idx, healthCheckSource := range c.healthCheckSources {
+		if healthCheckSource == nil {
+			continue
+		}
+		calls[idx] = c.callHealthCheckSource(ctx, idx, healthCheckSource)
+	}
+
+	statuses := make([]NamedHealthStatus, 0, len(calls))
+	var timedOut, panicked []string
+	var panicValues []interface{}
+	for idx := range c.healthCheckSources {
+		call, ok := calls[idx]
+		if !ok {
+			continue
+		}
+		name := HealthCheckSourceName(c.healthCheckSources[idx], idx)
+		var outcome sourceOutcome
+		select {
+		case <-call.done:
+			outcome = call.outcome
+		case <-ctx.Done():
+			// prefer a result that completed at the same time as the deadline
+			select {
+			case <-call.done:
+				outcome = call.outcome
+			default:
+				if last, ok := c.lastStatus(idx); ok {
+					statuses = append(statuses, NamedHealthStatus{Name: name, HealthStatus: c.timedOutHealthStatus(ctx, last)})
+				} else {
+					timedOut = append(timedOut, name)
+				}
+				continue
+			}
+		}
+		if outcome.panicked {
+			if last, ok := c.lastStatus(idx); ok {
+				statuses = append(statuses, NamedHealthStatus{Name: name, HealthStatus: panickedHealthStatus(last, outcome.panicValue)})
+			} else {
+				panicked = append(panicked, name)
+				panicValues = append(panicValues, outcome.panicValue)
+			}
+			continue
+		}
+		statuses = append(statuses, NamedHealthStatus{Name: name, HealthStatus: outcome.healthStatus})
+	}
+
+	if len(timedOut) > 0 {
+		message := "Health check sources timed out before returning a result"
+		statuses = append(statuses, syntheticHealthStatus(health.HealthCheckResult{
+			Type:    TimedOutHealthCheckSourceCheckType,
+			State:   health.New_HealthState(health.HealthState_REPAIRING),
+			Message: &message,
+			Params: c.withTimeoutParam(ctx, map[string]interface{}{
+				"sources": timedOut,
+			}),
+		}))
+	}
+	if len(panicked) > 0 {
+		message := "Health check sources panicked before returning a result"
+		statuses = append(statuses, syntheticHealthStatus(health.HealthCheckResult{
+			Type:    PanickedHealthCheckSourceCheckType,
+			State:   health.New_HealthState(health.HealthState_ERROR),
+			Message: &message,
+			Params: map[string]interface{}{
+				panicParam: panicStrings(panicValues),
+				"sources":  panicked,
+			},
+		}))
+	}
+	return statuses
+}
+
+// callHealthCheckSource calls the source in a new goroutine and returns the call, or returns the pending call of the
+// source if its previous call has not returned yet. The source is called with a context that is not canceled when ctx
+// is canceled but has the same deadline as ctx, so the call is bounded without depending on the caller that started it.
+func (c *combinedHealthCheckSource) callHealthCheckSource(ctx context.Context, idx int, healthCheckSource HealthCheckSource) *sourceCall {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if call, ok := c.inFlight[idx]; ok {
+		return call
+	}
+	call := &sourceCall{done: make(chan struct{})}
+	c.inFlight[idx] = call
+	callCtx, cancel := context.WithoutCancel(ctx), context.CancelFunc(func() {})
+	if deadline, ok := ctx.Deadline(); ok {
+		callCtx, cancel = context.WithDeadline(callCtx, deadline)
+	}
+	go c.runHealthCheckSource(callCtx, cancel, idx, healthCheckSource, call)
+	return call
+}
+
+// runHealthCheckSource calls the source and stores its result as the last status of the source unless the source
+// panicked or returned after ctx was done, in which case the result may only reflect the expired context.
+func (c *combinedHealthCheckSource) runHealthCheckSource(ctx context.Context, cancel context.CancelFunc, idx int, healthCheckSource HealthCheckSource, call *sourceCall) {
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			call.outcome = sourceOutcome{
+				panicked:   true,
+				panicValue: r,
+			}
+		}
+		c.mutex.Lock()
+		if !call.outcome.panicked && ctx.Err() == nil {
+			c.lastStatuses[idx] = call.outcome.healthStatus
+		}
+		delete(c.inFlight, idx)
+		c.mutex.Unlock()
+		close(call.done)
+	}()
+	call.outcome = sourceOutcome{
+		healthStatus: healthCheckSource.HealthStatus(ctx),
+	}
+}
+
+func (c *combinedHealthCheckSource) lastStatus(idx int) (health.HealthStatus, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	last, ok := c.lastStatuses[idx]
+	if !ok || len(last.Checks) == 0 {
+		return health.HealthStatus{}, false
+	}
+	return last, true
+}
+
+func (c *combinedHealthCheckSource) timedOutHealthStatus(ctx context.Context, last health.HealthStatus) health.HealthStatus {
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(last.Checks))
+	for checkType, lastResult := range last.Checks {
+		result := lastResult
+		if !c.config.lastKnownResultOnTimeout {
+			message := "Health check source timed out"
+			result = health.HealthCheckResult{
+				Type:    checkType,
+				State:   health.New_HealthState(health.HealthState_REPAIRING),
+				Message: &message,
+			}
+		}
+		result.Params = c.withTimeoutParam(ctx, result.Params)
+		checks[checkType] = result
+	}
+	return health.HealthStatus{Checks: checks}
+}
+
+// withTimeoutParam returns a copy of params with the timeout param set to the per-source timeout if one is configured,
+// or to the error of ctx otherwise.
+func (c *combinedHealthCheckSource) withTimeoutParam(ctx context.Context, params map[string]interface{}) map[string]interface{} {
+	timeout := c.config.perSourceTimeout.String()
+	if c.config.perSourceTimeout <= 0 {
+		timeout = context.Cause(ctx).Error()
+	}
+	return withParams(params, map[string]interface{}{timeoutParam: timeout})
+}
+
+func panickedHealthStatus(last health.HealthStatus, panicValue interface{}) health.HealthStatus {
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(last.Checks))
+	for checkType := range last.Checks {
+		message := "Health check source panicked"
+		checks[checkType] = health.HealthCheckResult{
+			Type:    checkType,
+			State:   health.New_HealthState(health.HealthState_ERROR),
+			Message: &message,
+			Params: map[string]interface{}{
+				panicParam: fmt.Sprint(panicValue),
+			},
+		}
+	}
+	return health.HealthStatus{Checks: checks}
+}
+
+func syntheticHealthStatus(result health.HealthCheckResult) NamedHealthStatus {
+	return NamedHealthStatus{
+		Name: string(result.Type),
+		HealthStatus: health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				result.Type: result,
+			},
+		},
+	}
+}
+
+func panicStrings(panicValues []interface{}) []string {
+	strs := make([]string, 0, len(panicValues))
+	for _, panicValue := range panicValues {
+		strs = append(strs, fmt.Sprint(panicValue))
+	}
+	return strs
+}
+
+// withParams returns a copy of params with the provided additional params set.
+func withParams(params, additional map[string]interface{}) map[string]interface{} {
+	paramsCopy := make(map[string]interface{}, len(params)+len(additional))
+	for k, v := range params {
+		paramsCopy[k] = v
+	}
+	for k, v := range additional {
+		paramsCopy[k] = v
+	}
+	return paramsCopy
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"time"
+)
+
+type CombinedOption func(conf *combinedHealthCheckSourceConfig)
+
+type combinedHealthCheckSourceConfig struct {
+	parallel                 bool
+	perSourceTimeout         time.Duration
+	lastKnownResultOnTimeout bool
+	conflictPolicy           ConflictPolicy
+	redactionPolicy          RedactionPolicy
+}
+
+func defaultCombinedHealthCheckSourceConfig() combinedHealthCheckSourceConfig {
+	return combinedHealthCheckSourceConfig{
+		parallel:                 false,
+		perSourceTimeout:         0,
+		lastKnownResultOnTimeout: false,
+		conflictPolicy:           LastSourceWins,
+		redactionPolicy:          "",
+	}
+}
+
+func (c *combinedHealthCheckSourceConfig) apply(options ...CombinedOption) {
+	for _, option := range options {
+		option(c)
+	}
+}
+
+// WithParallelHealthChecks makes the combined source call its sources concurrently. Each source must return within
+// perSourceTimeout and before the context provided to HealthStatus is done, otherwise its checks are reported as
+// timed out, with the healthCheckTimeout param set to perSourceTimeout or, if perSourceTimeout is non-positive, to the
+// error of the context provided to HealthStatus. A non-positive perSourceTimeout only applies the deadline of the
+// context provided to HealthStatus. Sources are called with a context that keeps the deadline but not the cancellation
+// of that context, and a result returned after that deadline is not kept as the last known result of the source.
+// A source that panics is reported as health.HealthState_ERROR. A source whose previous call has not returned yet is
+// not called again; its pending call is awaited instead, so a hung source does not accumulate goroutines.
+func WithParallelHealthChecks(perSourceTimeout time.Duration) CombinedOption {
+	return func(conf *combinedHealthCheckSourceConfig) {
+		conf.parallel = true
+		conf.perSourceTimeout = perSourceTimeout
+	}
+}
+
+// WithLastKnownResultOnTimeout makes a parallel combined source report the last result returned by a source that
+// timed out rather than marking its checks as health.HealthState_REPAIRING. Has no effect unless
+// WithParallelHealthChecks is also provided.
+func WithLastKnownResultOnTimeout() CombinedOption {
+	return func(conf *combinedHealthCheckSourceConfig) {
+		conf.lastKnownResultOnTimeout = true
+	}
+}
+
+// WithConflictPolicy sets the policy used when multiple sources report the same check type. Sources can be named for
+// the purposes of conflict resolution using NewNamedHealthCheckSource.
+func WithConflictPolicy(policy ConflictPolicy) CombinedOption {
+	return func(conf *combinedHealthCheckSourceConfig) {
+		conf.conflictPolicy = policy
+	}
+}
+
+// WithRedactionPolicy sets the policy applied to the unsafe params of the combined health status. If unset, unsafe
+// params are passed through unchanged so that they can be redacted by the consumer of the combined source, and are
+// serialized hashed otherwise.
+func WithRedactionPolicy(policy RedactionPolicy) CombinedOption {
+	return func(conf *combinedHealthCheckSourceConfig) {
+		conf.redactionPolicy = policy
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type funcHealthCheckSource func(ctx context.Context) health.HealthStatus
+
+func (f funcHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
+	return f(ctx)
+}
+
+func healthyStatus(checkType health.CheckType) health.HealthStatus {
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			checkType: {
+				Type:  checkType,
+				State: health.New_HealthState(health.HealthState_HEALTHY),
+			},
+		},
+	}
+}
+
+func TestParallelCombinedHealthCheckSource_RunsConcurrently(t *testing.T) {
+	var started sync.WaitGroup
+	started.Add(2)
+	newSource := func(checkType health.CheckType) HealthCheckSource {
+		return funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+			// each source blocks until both sources have started, which can only happen when run concurrently
+			started.Done()
+			started.Wait()
+			return healthyStatus(checkType)
+		})
+	}
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{newSource("A"), newSource("B"), nil},
+		WithParallelHealthChecks(time.Second),
+	)
+	actual := combined.HealthStatus(context.Background())
+	assert.Equal(t, health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"A": healthyStatus("A").Checks["A"],
+			"B": healthyStatus("B").Checks["B"],
+		},
+	}, actual)
+}
+
+func TestParallelCombinedHealthCheckSource_TimeoutWithoutPreviousResult(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	slow := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		<-block
+		return healthyStatus("SLOW")
+	})
+	fast := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		return healthyStatus("FAST")
+	})
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{slow, fast},
+		WithParallelHealthChecks(10*time.Millisecond),
+	)
+	actual := combined.HealthStatus(context.Background())
+	require.Len(t, actual.Checks, 2)
+	assert.Equal(t, health.HealthState_HEALTHY, actual.Checks["FAST"].State.Value())
+	timedOut := actual.Checks[TimedOutHealthCheckSourceCheckType]
+	assert.Equal(t, health.HealthState_REPAIRING, timedOut.State.Value())
+	assert.Equal(t, "10ms", timedOut.Params["healthCheckTimeout"])
+	assert.Equal(t, []string{"SOURCE_A"}, timedOut.Params["sources"])
+}
+
+func TestParallelCombinedHealthCheckSource_TimeoutWithPreviousResult(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		options       []CombinedOption
+		expectedState health.HealthState_Value
+	}{
+		{
+			name:          "repairing by default",
+			expectedState: health.HealthState_REPAIRING,
+		},
+		{
+			name:          "last known result",
+			options:       []CombinedOption{WithLastKnownResultOnTimeout()},
+			expectedState: health.HealthState_HEALTHY,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			block := make(chan struct{})
+			defer close(block)
+			var calls int
+			source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+				calls++
+				if calls > 1 {
+					<-block
+				}
+				return healthyStatus("SLOW")
+			})
+			combined := NewCombinedHealthCheckSourceWithOptions(
+				[]HealthCheckSource{source},
+				append([]CombinedOption{WithParallelHealthChecks(10 * time.Millisecond)}, tc.options...)...,
+			)
+			assert.Equal(t, healthyStatus("SLOW"), combined.HealthStatus(context.Background()))
+
+			actual := combined.HealthStatus(context.Background())
+			require.Len(t, actual.Checks, 1)
+			assert.Equal(t, tc.expectedState, actual.Checks["SLOW"].State.Value())
+			assert.Equal(t, "10ms", actual.Checks["SLOW"].Params["healthCheckTimeout"])
+		})
+	}
+}
+
+func TestParallelCombinedHealthCheckSource_RespectsContextDeadline(t *testing.T) {
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		<-ctx.Done()
+		return healthyStatus("SLOW")
+	})
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{source},
+		WithParallelHealthChecks(time.Hour),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	done := make(chan health.HealthStatus)
+	go func() {
+		done <- combined.HealthStatus(ctx)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("combined source did not respect context deadline")
+	}
+}
+
+func TestParallelCombinedHealthCheckSource_Panic(t *testing.T) {
+	var calls int
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		calls++
+		if calls > 1 {
+			panic("boom")
+		}
+		return healthyStatus("PANICKY")
+	})
+	alwaysPanics := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		panic("bang")
+	})
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{source, alwaysPanics},
+		WithParallelHealthChecks(time.Second),
+	)
+
+	actual := combined.HealthStatus(context.Background())
+	require.Len(t, actual.Checks, 2)
+	assert.Equal(t, health.HealthState_HEALTHY, actual.Checks["PANICKY"].State.Value())
+	panicked := actual.Checks[PanickedHealthCheckSourceCheckType]
+	assert.Equal(t, health.HealthState_ERROR, panicked.State.Value())
+	assert.Equal(t, []string{"bang"}, panicked.Params["healthCheckPanic"])
+	assert.Equal(t, []string{"SOURCE_B"}, panicked.Params["sources"])
+
+	actual = combined.HealthStatus(context.Background())
+	require.Len(t, actual.Checks, 2)
+	assert.Equal(t, health.HealthState_ERROR, actual.Checks["PANICKY"].State.Value())
+	assert.Equal(t, "boom", actual.Checks["PANICKY"].Params["healthCheckPanic"])
+}
+
+func TestParallelCombinedHealthCheckSource_NonPositiveTimeout(t *testing.T) {
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		time.Sleep(10 * time.Millisecond)
+		return healthyStatus("SLOW")
+	})
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{source},
+		WithParallelHealthChecks(0),
+	)
+	assert.Equal(t, healthyStatus("SLOW"), combined.HealthStatus(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	actual := combined.HealthStatus(ctx)
+	require.Len(t, actual.Checks, 1)
+	assert.Equal(t, health.HealthState_REPAIRING, actual.Checks["SLOW"].State.Value())
+	assert.Equal(t, context.DeadlineExceeded.Error(), actual.Checks["SLOW"].Params["healthCheckTimeout"])
+}
+
+func TestParallelCombinedHealthCheckSource_LateResultDoesNotReplaceLastResult(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	lateReturned := make(chan struct{})
+	var calls int32
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			return healthyStatus("SLOW")
+		case 2:
+			defer close(lateReturned)
+			<-ctx.Done()
+			return health.HealthStatus{
+				Checks: map[health.CheckType]health.HealthCheckResult{
+					"SLOW": {
+						Type:  "SLOW",
+						State: health.New_HealthState(health.HealthState_ERROR),
+					},
+				},
+			}
+		default:
+			<-block
+			return healthyStatus("SLOW")
+		}
+	})
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{source},
+		WithParallelHealthChecks(10*time.Millisecond),
+		WithLastKnownResultOnTimeout(),
+	)
+	assert.Equal(t, healthyStatus("SLOW"), combined.HealthStatus(context.Background()))
+
+	// the call started by a canceled caller is not canceled and only times out after the per-source timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	actual := combined.HealthStatus(ctx)
+	assert.Equal(t, health.HealthState_HEALTHY, actual.Checks["SLOW"].State.Value())
+	select {
+	case <-lateReturned:
+		t.Fatal("source call was canceled with its caller")
+	case <-time.After(5 * time.Millisecond):
+	}
+	<-lateReturned
+
+	// the result returned after the call timed out does not replace the last known result
+	require.Eventually(t, func() bool {
+		c := combined.(*combinedHealthCheckSource)
+		c.mutex.RLock()
+		defer c.mutex.RUnlock()
+		return len(c.inFlight) == 0
+	}, time.Second, time.Millisecond)
+	actual = combined.HealthStatus(context.Background())
+	assert.Equal(t, health.HealthState_HEALTHY, actual.Checks["SLOW"].State.Value())
+	assert.Equal(t, "10ms", actual.Checks["SLOW"].Params["healthCheckTimeout"])
+}
+
+func TestParallelCombinedHealthCheckSource_SkipsSourceInFlight(t *testing.T) {
+	block := make(chan struct{})
+	var calls int32
+	source := funcHealthCheckSource(func(ctx context.Context) health.HealthStatus {
+		atomic.AddInt32(&calls, 1)
+		<-block
+		return healthyStatus("HUNG")
+	})
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{source},
+		WithParallelHealthChecks(50*time.Millisecond),
+	)
+	for i := 0; i < 3; i++ {
+		actual := combined.HealthStatus(context.Background())
+		assert.Contains(t, actual.Checks, TimedOutHealthCheckSourceCheckType)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	close(block)
+	assert.Equal(t, healthyStatus("HUNG"), combined.HealthStatus(context.Background()))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, healthyStatus("HUNG"), combined.HealthStatus(context.Background()))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// ConflictPolicy determines how results are merged when multiple sources report the same check type.
+type ConflictPolicy string
+
+const (
+	// LastSourceWins uses the result reported by the last source. This is the default policy.
+	LastSourceWins ConflictPolicy = "LastSourceWins"
+	// WorstStateWins uses the result with the most severe health state. If multiple results have the same state,
+	// the result reported by the last of those sources is used.
+	WorstStateWins ConflictPolicy = "WorstStateWins"
+	// ErrorOnConflict replaces the conflicting results with a single health.HealthState_ERROR result whose params
+	// name the conflicting sources.
+	ErrorOnConflict ConflictPolicy = "ErrorOnConflict"
+	// NamespaceConflicts reports every conflicting result under a check type prefixed with the name of the source
+	// that reported it, for example "DATABASE_CONNECTIVITY".
+	NamespaceConflicts ConflictPolicy = "NamespaceConflicts"
+)
+
+// NamedHealthCheckSource is a HealthCheckSource with a name used to identify it when merging conflicting results.
+// Names should be uppercase, underscore delimited, ascii letters so that namespaced check types remain valid.
+type NamedHealthCheckSource interface {
+	HealthCheckSource
+	Name() string
+}
+
+type namedHealthCheckSource struct {
+	HealthCheckSource
+	name string
+}
+
+// NewNamedHealthCheckSource returns a NamedHealthCheckSource that reports the health status of source.
+func NewNamedHealthCheckSource(name string, source HealthCheckSource) NamedHealthCheckSource {
+	return &namedHealthCheckSource{
+		HealthCheckSource: source,
+		name:              name,
+	}
+}
+
+func (n *namedHealthCheckSource) Name() string {
+	return n.name
+}
+
+// HealthCheckSourceName returns the name of the source if it is a NamedHealthCheckSource. Otherwise, it returns a
+// name derived from the position of the source, for example "SOURCE_A" for the source at index 0.
+func HealthCheckSourceName(source HealthCheckSource, idx int) string {
+	if named, ok := source.(NamedHealthCheckSource); ok {
+		return named.Name()
+	}
+	suffix := ""
+	for idx >= 0 {
+		suffix = string(rune('A'+idx%26)) + suffix
+		idx = idx/26 - 1
+	}
+	return "SOURCE_" + suffix
+}
+
+// NamedHealthStatus is a health status along with the name of the source that reported it.
+type NamedHealthStatus struct {
+	Name         string
+	HealthStatus health.HealthStatus
+}
+
+// NewNamedHealthStatus returns the health status of the source along with its HealthCheckSourceName.
+func NewNamedHealthStatus(ctx context.Context, source HealthCheckSource, idx int) NamedHealthStatus {
+	return NamedHealthStatus{
+		Name:         HealthCheckSourceName(source, idx),
+		HealthStatus: source.HealthStatus(ctx),
+	}
+}
+
+// MergeHealthStatuses merges the checks of all provided health statuses into a single health status, resolving check
+// types reported by multiple statuses using the provided policy. An empty policy behaves like LastSourceWins.
+func MergeHealthStatuses(policy ConflictPolicy, statuses ...NamedHealthStatus) health.HealthStatus {
+	type namedResult struct {
+		name   string
+		result health.HealthCheckResult
+	}
+	resultsByCheckType := make(map[health.CheckType][]namedResult)
+	for _, namedStatus := range statuses {
+		for checkType, result := range namedStatus.HealthStatus.Checks {
+			resultsByCheckType[checkType] = append(resultsByCheckType[checkType], namedResult{
+				name:   namedStatus.Name,
+				result: result,
+			})
+		}
+	}
+
+	merged := health.HealthStatus{
+		Checks: make(map[health.CheckType]health.HealthCheckResult, len(resultsByCheckType)),
+	}
+	for checkType, results := range resultsByCheckType {
+		if len(results) == 1 {
+			merged.Checks[checkType] = results[0].result
+			continue
+		}
+		switch policy {
+		case WorstStateWins:
+			worst := results[0].result
+			for _, namedResult := range results[1:] {
+				if CompareHealthStates(namedResult.result.State, worst.State) >= 0 {
+					worst = namedResult.result
+				}
+			}
+			merged.Checks[checkType] = worst
+		case ErrorOnConflict:
+			names := make([]string, 0, len(results))
+			for _, namedResult := range results {
+				names = append(names, namedResult.name)
+			}
+			message := "Multiple health check sources reported the same check type"
+			merged.Checks[checkType] = health.HealthCheckResult{
+				Type:    checkType,
+				State:   health.New_HealthState(health.HealthState_ERROR),
+				Message: &message,
+				Params: map[string]interface{}{
+					"sources": names,
+				},
+			}
+		case NamespaceConflicts:
+			for _, namedResult := range results {
+				namespacedCheckType := health.CheckType(namedResult.name + "_" + string(checkType))
+				result := namedResult.result
+				result.Type = namespacedCheckType
+				merged.Checks[namespacedCheckType] = result
+			}
+		default:
+			merged.Checks[checkType] = results[len(results)-1].result
+		}
+	}
+	return merged
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func stateStatus(checkType health.CheckType, state health.HealthState_Value) health.HealthStatus {
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			checkType: {
+				Type:  checkType,
+				State: health.New_HealthState(state),
+			},
+		},
+	}
+}
+
+func TestMergeHealthStatuses(t *testing.T) {
+	statuses := []NamedHealthStatus{
+		{Name: "FIRST", HealthStatus: stateStatus("SHARED", health.HealthState_ERROR)},
+		{Name: "SECOND", HealthStatus: stateStatus("SHARED", health.HealthState_WARNING)},
+		{Name: "THIRD", HealthStatus: stateStatus("UNIQUE", health.HealthState_HEALTHY)},
+	}
+	conflictMessage := "Multiple health check sources reported the same check type"
+	for _, tc := range []struct {
+		policy   ConflictPolicy
+		expected map[health.CheckType]health.HealthCheckResult
+	}{
+		{
+			policy: LastSourceWins,
+			expected: map[health.CheckType]health.HealthCheckResult{
+				"SHARED": stateStatus("SHARED", health.HealthState_WARNING).Checks["SHARED"],
+				"UNIQUE": stateStatus("UNIQUE", health.HealthState_HEALTHY).Checks["UNIQUE"],
+			},
+		},
+		{
+			policy: WorstStateWins,
+			expected: map[health.CheckType]health.HealthCheckResult{
+				"SHARED": stateStatus("SHARED", health.HealthState_ERROR).Checks["SHARED"],
+				"UNIQUE": stateStatus("UNIQUE", health.HealthState_HEALTHY).Checks["UNIQUE"],
+			},
+		},
+		{
+			policy: ErrorOnConflict,
+			expected: map[health.CheckType]health.HealthCheckResult{
+				"SHARED": {
+					Type:    "SHARED",
+					State:   health.New_HealthState(health.HealthState_ERROR),
+					Message: &conflictMessage,
+					Params: map[string]interface{}{
+						"sources": []string{"FIRST", "SECOND"},
+					},
+				},
+				"UNIQUE": stateStatus("UNIQUE", health.HealthState_HEALTHY).Checks["UNIQUE"],
+			},
+		},
+		{
+			policy: NamespaceConflicts,
+			expected: map[health.CheckType]health.HealthCheckResult{
+				"FIRST_SHARED":  stateStatus("FIRST_SHARED", health.HealthState_ERROR).Checks["FIRST_SHARED"],
+				"SECOND_SHARED": stateStatus("SECOND_SHARED", health.HealthState_WARNING).Checks["SECOND_SHARED"],
+				"UNIQUE":        stateStatus("UNIQUE", health.HealthState_HEALTHY).Checks["UNIQUE"],
+			},
+		},
+	} {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			assert.Equal(t, health.HealthStatus{Checks: tc.expected}, MergeHealthStatuses(tc.policy, statuses...))
+		})
+	}
+}
+
+func TestCombinedHealthCheckSourceWithConflictPolicy(t *testing.T) {
+	sourceA := &testHealthCheckSource{healthStatus: stateStatus("SHARED", health.HealthState_ERROR)}
+	sourceB := &testHealthCheckSource{healthStatus: stateStatus("SHARED", health.HealthState_HEALTHY)}
+	combined := NewCombinedHealthCheckSourceWithOptions(
+		[]HealthCheckSource{sourceA, NewNamedHealthCheckSource("NAMED", sourceB)},
+		WithConflictPolicy(NamespaceConflicts),
+	)
+	assert.Equal(t, health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"SOURCE_A_SHARED": stateStatus("SOURCE_A_SHARED", health.HealthState_ERROR).Checks["SOURCE_A_SHARED"],
+			"NAMED_SHARED":    stateStatus("NAMED_SHARED", health.HealthState_HEALTHY).Checks["NAMED_SHARED"],
+		},
+	}, combined.HealthStatus(context.Background()))
+}
+
+func TestHealthCheckSourceName(t *testing.T) {
+	source := &testHealthCheckSource{}
+	assert.Equal(t, "SOURCE_A", HealthCheckSourceName(source, 0))
+	assert.Equal(t, "SOURCE_Z", HealthCheckSourceName(source, 25))
+	assert.Equal(t, "SOURCE_AA", HealthCheckSourceName(source, 26))
+	assert.Equal(t, "NAMED", HealthCheckSourceName(NewNamedHealthCheckSource("NAMED", source), 0))
+}
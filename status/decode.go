@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/palantir/pkg/safejson"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+const (
+	// StatusCodeHealthCheckType is the check type of the synthetic check that DecodeHealthStatus derives from the
+	// status code of a health response without a health status in its body.
+	StatusCodeHealthCheckType health.CheckType = "HEALTH_STATUS_CODE"
+	// StatusCodeParam is the param of the synthetic StatusCodeHealthCheckType check that holds the status code.
+	StatusCodeParam = "statusCode"
+)
+
+// DecodeHealthResponse reads the body of resp and decodes it using DecodeHealthStatus. The body is not closed.
+func DecodeHealthResponse(resp *http.Response) (health.HealthStatus, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return health.HealthStatus{}, werror.Wrap(err, "failed to read health response body")
+	}
+	return DecodeHealthStatus(resp.StatusCode, body)
+}
+
+// DecodeHealthStatus returns the health status of a health response with the provided status code and body.
+//
+// If the body holds a JSON-encoded health status with at least one check, or with no checks and http.StatusOK, that
+// health status is returned. Otherwise, a health status with a single synthetic StatusCodeHealthCheckType check in
+// the state that HealthStateFromStatusCode derives from the status code is returned if the body is empty, or if the
+// status code is not successful, since error responses of health endpoints may not have a health status in their body.
+// Returns an error in all other cases, in particular for successful responses with a body that is not a health status
+// such as the login page of a proxy, so that a misconfigured endpoint is never mistaken for a healthy one.
+func DecodeHealthStatus(code int, body []byte) (health.HealthStatus, error) {
+	var healthStatus health.HealthStatus
+	decodeErr := safejson.Unmarshal(body, &healthStatus)
+	if decodeErr == nil && (len(healthStatus.Checks) > 0 || code == http.StatusOK) {
+		if healthStatus.Checks == nil {
+			healthStatus.Checks = make(map[health.CheckType]health.HealthCheckResult)
+		}
+		return healthStatus, nil
+	}
+	if decodeErr == nil {
+		decodeErr = werror.Error("health response has no checks")
+	}
+	state, ok := HealthStateFromStatusCode(code)
+	if !ok || (len(bytes.TrimSpace(body)) > 0 && code >= 200 && code < 300) {
+		return health.HealthStatus{}, werror.Wrap(decodeErr, "failed to decode health response",
+			werror.SafeParam(StatusCodeParam, code))
+	}
+	message := "Health state derived from the status code of the health response"
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			StatusCodeHealthCheckType: {
+				Type:    StatusCodeHealthCheckType,
+				State:   health.New_HealthState(state),
+				Message: &message,
+				Params: map[string]interface{}{
+					StatusCodeParam: code,
+				},
+			},
+		},
+	}, nil
+}
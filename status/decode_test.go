@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeHealthStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		code          int
+		body          string
+		expectedState map[health.CheckType]health.HealthState_Value
+		expectedError bool
+	}{
+		{
+			name:          "decodes health status from body",
+			code:          522,
+			body:          `{"checks":{"CHECK":{"type":"CHECK","state":"ERROR"}}}`,
+			expectedState: map[health.CheckType]health.HealthState_Value{"CHECK": health.HealthState_ERROR},
+		},
+		{
+			name:          "decodes healthy status without checks",
+			code:          http.StatusOK,
+			body:          `{"checks":{}}`,
+			expectedState: map[health.CheckType]health.HealthState_Value{},
+		},
+		{
+			name:          "derives check from status code of empty body",
+			code:          521,
+			expectedState: map[health.CheckType]health.HealthState_Value{StatusCodeHealthCheckType: health.HealthState_WARNING},
+		},
+		{
+			name:          "derives check from status code of empty successful body",
+			code:          http.StatusOK,
+			expectedState: map[health.CheckType]health.HealthState_Value{StatusCodeHealthCheckType: health.HealthState_HEALTHY},
+		},
+		{
+			name:          "derives check from error status code of unparseable body",
+			code:          522,
+			body:          "Internal Error",
+			expectedState: map[health.CheckType]health.HealthState_Value{StatusCodeHealthCheckType: health.HealthState_ERROR},
+		},
+		{
+			name:          "fails for unparseable successful body",
+			code:          http.StatusOK,
+			body:          "<html>login page</html>",
+			expectedError: true,
+		},
+		{
+			name:          "derives check from status code inconsistent with empty checks",
+			code:          523,
+			body:          `{"checks":{}}`,
+			expectedState: map[health.CheckType]health.HealthState_Value{StatusCodeHealthCheckType: health.HealthState_TERMINAL},
+		},
+		{
+			name:          "fails for unparseable body with unknown status code",
+			code:          http.StatusNotFound,
+			body:          "Not Found",
+			expectedError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			healthStatus, err := DecodeHealthStatus(tc.code, []byte(tc.body))
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			actual := make(map[health.CheckType]health.HealthState_Value, len(healthStatus.Checks))
+			for checkType, result := range healthStatus.Checks {
+				actual[checkType] = result.State.Value()
+			}
+			assert.Equal(t, tc.expectedState, actual)
+			if result, ok := healthStatus.Checks[StatusCodeHealthCheckType]; ok {
+				assert.Equal(t, tc.code, result.Params[StatusCodeParam])
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// TransitionDirection describes whether a check transition changed the severity of a check.
+type TransitionDirection string
+
+const (
+	// Degradation indicates that the check became more severe.
+	Degradation TransitionDirection = "Degradation"
+	// Recovery indicates that the check became less severe.
+	Recovery TransitionDirection = "Recovery"
+	// Unchanged indicates that the severity of the check did not change.
+	Unchanged TransitionDirection = "Unchanged"
+)
+
+// CheckTransition describes how a single check changed between two health statuses.
+type CheckTransition struct {
+	CheckType health.CheckType
+	// Old is the previous result of the check, or nil if the check was added.
+	Old *health.HealthCheckResult
+	// New is the current result of the check, or nil if the check was removed.
+	New *health.HealthCheckResult
+	// OldState is the previous state of the check, or nil if the check was added.
+	OldState *health.HealthState
+	// NewState is the current state of the check, or nil if the check was removed.
+	NewState *health.HealthState
+	// StateChanged is true if the check was added, removed or changed state.
+	StateChanged bool
+	// MessageChanged is true if the check was added, removed or changed message.
+	MessageChanged bool
+	// ParamsChanged is true if the check was added, removed or changed params.
+	ParamsChanged bool
+	// Direction describes the change in severity of the check. Added and removed checks are compared against
+	// health.HealthState_HEALTHY.
+	Direction TransitionDirection
+}
+
+// Added returns true if the check was not present in the old health status.
+func (t CheckTransition) Added() bool {
+	return t.Old == nil
+}
+
+// Removed returns true if the check is not present in the new health status.
+func (t CheckTransition) Removed() bool {
+	return t.New == nil
+}
+
+// Diff returns the transitions of all checks that differ between oldStatus and newStatus, ordered by check type,
+// using DefaultHealthStateSeverity to determine the direction of each transition.
+func Diff(oldStatus, newStatus health.HealthStatus) []CheckTransition {
+	return HealthStateSeverity(DefaultHealthStateSeverity).Diff(oldStatus, newStatus)
+}
+
+// Diff returns the transitions of all checks that differ between oldStatus and newStatus, ordered by check type,
+// using s to determine the direction of each transition.
+func (s HealthStateSeverity) Diff(oldStatus, newStatus health.HealthStatus) []CheckTransition {
+	checkTypes := make(map[health.CheckType]struct{}, len(oldStatus.Checks)+len(newStatus.Checks))
+	for checkType := range oldStatus.Checks {
+		checkTypes[checkType] = struct{}{}
+	}
+	for checkType := range newStatus.Checks {
+		checkTypes[checkType] = struct{}{}
+	}
+
+	var transitions []CheckTransition
+	for checkType := range checkTypes {
+		transition := CheckTransition{
+			CheckType: checkType,
+		}
+		if oldResult, ok := oldStatus.Checks[checkType]; ok {
+			transition.Old = &oldResult
+			transition.OldState = &oldResult.State
+		}
+		if newResult, ok := newStatus.Checks[checkType]; ok {
+			transition.New = &newResult
+			transition.NewState = &newResult.State
+		}
+		if transition.Old == nil || transition.New == nil {
+			transition.StateChanged = true
+			transition.MessageChanged = true
+			transition.ParamsChanged = true
+		} else {
+			// compare the raw values so that changes between different unknown states are reported
+			transition.StateChanged = transition.Old.State.String() != transition.New.State.String()
+			transition.MessageChanged = !messagesEqual(transition.Old.Message, transition.New.Message)
+			transition.ParamsChanged = !paramsEqual(transition.Old.Params, transition.New.Params)
+			if !transition.StateChanged && !transition.MessageChanged && !transition.ParamsChanged {
+				continue
+			}
+		}
+		transition.Direction = s.direction(transition.OldState, transition.NewState)
+		transitions = append(transitions, transition)
+	}
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].CheckType < transitions[j].CheckType
+	})
+	return transitions
+}
+
+func (s HealthStateSeverity) direction(oldState, newState *health.HealthState) TransitionDirection {
+	healthy := health.New_HealthState(health.HealthState_HEALTHY)
+	if oldState == nil {
+		oldState = &healthy
+	}
+	if newState == nil {
+		newState = &healthy
+	}
+	switch s.Compare(*newState, *oldState) {
+	case 1:
+		return Degradation
+	case -1:
+		return Recovery
+	default:
+		return Unchanged
+	}
+}
+
+func messagesEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// paramsEqual returns true if both params have the same entries. Nil and empty params are considered equal.
+func paramsEqual(a, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
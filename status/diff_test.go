@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"testing"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	oldMessage := "old"
+	newMessage := "new"
+	oldStatus := health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"DEGRADED":  {Type: "DEGRADED", State: health.New_HealthState(health.HealthState_HEALTHY)},
+			"RECOVERED": {Type: "RECOVERED", State: health.New_HealthState(health.HealthState_ERROR)},
+			"MESSAGE":   {Type: "MESSAGE", State: health.New_HealthState(health.HealthState_WARNING), Message: &oldMessage},
+			"PARAMS":    {Type: "PARAMS", State: health.New_HealthState(health.HealthState_WARNING), Params: map[string]interface{}{"key": "old"}},
+			"REMOVED":   {Type: "REMOVED", State: health.New_HealthState(health.HealthState_ERROR)},
+			"SAME":      {Type: "SAME", State: health.New_HealthState(health.HealthState_WARNING), Message: &oldMessage},
+			"EMPTY":     {Type: "EMPTY", State: health.New_HealthState(health.HealthState_HEALTHY)},
+		},
+	}
+	newStatus := health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"ADDED":     {Type: "ADDED", State: health.New_HealthState(health.HealthState_WARNING)},
+			"DEGRADED":  {Type: "DEGRADED", State: health.New_HealthState(health.HealthState_REPAIRING)},
+			"RECOVERED": {Type: "RECOVERED", State: health.New_HealthState(health.HealthState_HEALTHY)},
+			"MESSAGE":   {Type: "MESSAGE", State: health.New_HealthState(health.HealthState_WARNING), Message: &newMessage},
+			"PARAMS":    {Type: "PARAMS", State: health.New_HealthState(health.HealthState_WARNING), Params: map[string]interface{}{"key": "new"}},
+			"SAME":      {Type: "SAME", State: health.New_HealthState(health.HealthState_WARNING), Message: &oldMessage},
+			"EMPTY":     {Type: "EMPTY", State: health.New_HealthState(health.HealthState_HEALTHY), Params: map[string]interface{}{}},
+		},
+	}
+
+	transitions := Diff(oldStatus, newStatus)
+	require.Len(t, transitions, 6)
+
+	type summary struct {
+		checkType      health.CheckType
+		added          bool
+		removed        bool
+		stateChanged   bool
+		messageChanged bool
+		paramsChanged  bool
+		direction      TransitionDirection
+	}
+	var actual []summary
+	for _, transition := range transitions {
+		actual = append(actual, summary{
+			checkType:      transition.CheckType,
+			added:          transition.Added(),
+			removed:        transition.Removed(),
+			stateChanged:   transition.StateChanged,
+			messageChanged: transition.MessageChanged,
+			paramsChanged:  transition.ParamsChanged,
+			direction:      transition.Direction,
+		})
+	}
+	assert.Equal(t, []summary{
+		{checkType: "ADDED", added: true, stateChanged: true, messageChanged: true, paramsChanged: true, direction: Degradation},
+		{checkType: "DEGRADED", stateChanged: true, direction: Degradation},
+		{checkType: "MESSAGE", messageChanged: true, direction: Unchanged},
+		{checkType: "PARAMS", paramsChanged: true, direction: Unchanged},
+		{checkType: "RECOVERED", stateChanged: true, direction: Recovery},
+		{checkType: "REMOVED", removed: true, stateChanged: true, messageChanged: true, paramsChanged: true, direction: Recovery},
+	}, actual)
+
+	degraded := transitions[1]
+	require.NotNil(t, degraded.OldState)
+	require.NotNil(t, degraded.NewState)
+	assert.Equal(t, health.HealthState_HEALTHY, degraded.OldState.Value())
+	assert.Equal(t, health.HealthState_REPAIRING, degraded.NewState.Value())
+	assert.Nil(t, transitions[0].OldState)
+	assert.Nil(t, transitions[5].NewState)
+}
+
+func TestDiffNoChanges(t *testing.T) {
+	healthStatus := health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"CHECK": {Type: "CHECK", State: health.New_HealthState(health.HealthState_ERROR)},
+		},
+	}
+	assert.Empty(t, Diff(healthStatus, healthStatus))
+	assert.Empty(t, Diff(health.HealthStatus{}, health.HealthStatus{}))
+}
+
+func TestDiffUnknownStates(t *testing.T) {
+	oldStatus := health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"CHECK": {Type: "CHECK", State: health.New_HealthState("BROKEN")},
+		},
+	}
+	newStatus := health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"CHECK": {Type: "CHECK", State: health.New_HealthState("GONE")},
+		},
+	}
+	transitions := Diff(oldStatus, newStatus)
+	require.Len(t, transitions, 1)
+	assert.True(t, transitions[0].StateChanged)
+	assert.False(t, transitions[0].MessageChanged)
+	assert.Equal(t, "GONE", transitions[0].NewState.String())
+	assert.Empty(t, Diff(oldStatus, oldStatus))
+}
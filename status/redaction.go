@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// UnsafeParam is the value of a health check result param that may contain sensitive information, such as the
+// message of an error. Unsafe params fail closed: they are serialized like params hashed using HashUnsafeParams, so
+// that a health status that leaves the process without being redacted does not leak their values. Their values are
+// only serialized once revealed using RedactHealthStatus with KeepUnsafeParams.
+type UnsafeParam struct {
+	Value interface{}
+}
+
+// NewUnsafeParam marks value as unsafe so that it can be redacted by a RedactionPolicy.
+func NewUnsafeParam(value interface{}) UnsafeParam {
+	return UnsafeParam{Value: value}
+}
+
+func (p UnsafeParam) MarshalJSON() ([]byte, error) {
+	return safejson.Marshal(hashUnsafeParam(p))
+}
+
+// RedactionPolicy determines how unsafe params are treated when a health status is redacted.
+type RedactionPolicy string
+
+const (
+	// KeepUnsafeParams replaces unsafe params with their values, which reveals them when the health status is
+	// serialized. Should only be used for health statuses served to trusted clients.
+	KeepUnsafeParams RedactionPolicy = "KEEP"
+	// DropUnsafeParams removes unsafe params.
+	DropUnsafeParams RedactionPolicy = "DROP"
+	// HashUnsafeParams replaces the value of unsafe params with the hex-encoded SHA-256 hash of their JSON encoding,
+	// prefixed with "sha256:", so that equal values can still be correlated.
+	HashUnsafeParams RedactionPolicy = "HASH"
+)
+
+// RedactHealthStatus returns a copy of healthStatus in which the unsafe params of all checks are treated according to
+// policy. Results whose params contain no unsafe params are returned unchanged.
+func RedactHealthStatus(policy RedactionPolicy, healthStatus health.HealthStatus) health.HealthStatus {
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(healthStatus.Checks))
+	for checkType, result := range healthStatus.Checks {
+		checks[checkType] = RedactHealthCheckResult(policy, result)
+	}
+	return health.HealthStatus{Checks: checks}
+}
+
+// RedactHealthCheckResult returns a copy of result in which the unsafe params are treated according to policy.
+func RedactHealthCheckResult(policy RedactionPolicy, result health.HealthCheckResult) health.HealthCheckResult {
+	if !hasUnsafeParams(result.Params) {
+		return result
+	}
+	params := make(map[string]interface{}, len(result.Params))
+	for key, value := range result.Params {
+		unsafeParam, ok := unsafeParamValue(value)
+		if !ok {
+			params[key] = value
+			continue
+		}
+		switch policy {
+		case KeepUnsafeParams:
+			params[key] = unsafeParam.Value
+		case HashUnsafeParams:
+			params[key] = hashUnsafeParam(unsafeParam)
+		}
+	}
+	result.Params = params
+	return result
+}
+
+func hasUnsafeParams(params map[string]interface{}) bool {
+	for _, value := range params {
+		if _, ok := unsafeParamValue(value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func unsafeParamValue(value interface{}) (UnsafeParam, bool) {
+	switch v := value.(type) {
+	case UnsafeParam:
+		return v, true
+	case *UnsafeParam:
+		if v == nil {
+			return UnsafeParam{}, true
+		}
+		return *v, true
+	default:
+		return UnsafeParam{}, false
+	}
+}
+
+func hashUnsafeParam(param UnsafeParam) string {
+	encoded, err := safejson.Marshal(param.Value)
+	if err != nil {
+		encoded = []byte(fmt.Sprint(param.Value))
+	}
+	sum := sha256.Sum256(encoded)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
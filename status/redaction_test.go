@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func unsafeHealthStatus() health.HealthStatus {
+	return health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"CHECK": {
+				Type:  "CHECK",
+				State: health.New_HealthState(health.HealthState_ERROR),
+				Params: map[string]interface{}{
+					"safe":   "value",
+					"unsafe": NewUnsafeParam("customer@example.com"),
+				},
+			},
+			"OTHER": {
+				Type:  "OTHER",
+				State: health.New_HealthState(health.HealthState_HEALTHY),
+			},
+		},
+	}
+}
+
+func TestRedactHealthStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		policy         RedactionPolicy
+		expectedParams map[string]interface{}
+	}{
+		{
+			name:   "reveals unsafe params",
+			policy: KeepUnsafeParams,
+			expectedParams: map[string]interface{}{
+				"safe":   "value",
+				"unsafe": "customer@example.com",
+			},
+		},
+		{
+			name:   "drops unsafe params",
+			policy: DropUnsafeParams,
+			expectedParams: map[string]interface{}{
+				"safe": "value",
+			},
+		},
+		{
+			name:   "hashes unsafe params",
+			policy: HashUnsafeParams,
+			expectedParams: map[string]interface{}{
+				"safe":   "value",
+				"unsafe": "sha256:455d095fd0751735dac1a99270fc62af95c6d7e5e0f12dc07b99f66ab69442f6",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			original := unsafeHealthStatus()
+			redacted := RedactHealthStatus(tc.policy, original)
+			assert.Equal(t, tc.expectedParams, redacted.Checks["CHECK"].Params)
+			assert.Equal(t, original.Checks["OTHER"], redacted.Checks["OTHER"])
+			assert.Equal(t, unsafeHealthStatus(), original)
+		})
+	}
+}
+
+func TestUnsafeParamMarshalJSON(t *testing.T) {
+	body, err := safejson.Marshal(unsafeHealthStatus().Checks["CHECK"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"CHECK","state":"ERROR","message":null,"params":{"safe":"value","unsafe":"sha256:455d095fd0751735dac1a99270fc62af95c6d7e5e0f12dc07b99f66ab69442f6"}}`, string(body))
+
+	body, err = safejson.Marshal(RedactHealthStatus(KeepUnsafeParams, unsafeHealthStatus()).Checks["CHECK"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"CHECK","state":"ERROR","message":null,"params":{"safe":"value","unsafe":"customer@example.com"}}`, string(body))
+}
+
+func TestCombinedHealthCheckSourceRedaction(t *testing.T) {
+	combined := NewCombinedHealthCheckSourceWithOptions([]HealthCheckSource{
+		&testHealthCheckSource{healthStatus: unsafeHealthStatus()},
+	}, WithRedactionPolicy(DropUnsafeParams))
+	assert.Equal(t, map[string]interface{}{"safe": "value"}, combined.HealthStatus(context.Background()).Checks["CHECK"].Params)
+
+	combined = NewCombinedHealthCheckSource(&testHealthCheckSource{healthStatus: unsafeHealthStatus()})
+	assert.Equal(t, unsafeHealthStatus().Checks["CHECK"].Params, combined.HealthStatus(context.Background()).Checks["CHECK"].Params)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// HealthStateSeverity ranks health states by severity, where a higher rank is more severe.
+//
+// Regardless of the ranking, states that are not recognized, such as states reported by a newer version of a remote
+// service, are more severe than all recognized states when compared using the methods of HealthStateSeverity, so that
+// they are never hidden by a less severe state.
+type HealthStateSeverity func(state health.HealthState_Value) int
+
+// DefaultHealthStateSeverity ranks health states by their HealthStateStatusCode, which orders the known states from
+// least to most severe as HEALTHY, DEFERRING, SUSPENDED, REPAIRING, WARNING, ERROR and TERMINAL. States that are not
+// recognized are ranked as more severe than TERMINAL.
+func DefaultHealthStateSeverity(state health.HealthState_Value) int {
+	code, ok := healthStateStatusCodes[state]
+	if !ok {
+		return healthStateStatusCodes[health.HealthState_TERMINAL] + 1
+	}
+	return code
+}
+
+// NewHealthStateSeverity returns a HealthStateSeverity that ranks the provided states from least to most severe.
+// Recognized states that are not provided are ranked as more severe than all provided states, and states that are not
+// recognized are ranked as more severe than those.
+func NewHealthStateSeverity(states ...health.HealthState_Value) HealthStateSeverity {
+	ranks := make(map[health.HealthState_Value]int, len(states))
+	for idx, state := range states {
+		ranks[state] = idx
+	}
+	return func(state health.HealthState_Value) int {
+		if state == health.HealthState_UNKNOWN {
+			return len(states) + 1
+		}
+		rank, ok := ranks[state]
+		if !ok {
+			return len(states)
+		}
+		return rank
+	}
+}
+
+// AggregateHealthResult is the result of aggregating a health status into a single health state.
+type AggregateHealthResult struct {
+	// State is the most severe state of all checks, or health.HealthState_HEALTHY if there are no checks.
+	State health.HealthState
+	// DrivingCheck is the check that determined State, or nil if there are no checks. If multiple checks share the
+	// most severe state, the check with the lexicographically smallest check type is used.
+	DrivingCheck *health.HealthCheckResult
+}
+
+// Compare returns -1 if a is less severe than b, 1 if a is more severe than b and 0 if they are equally severe.
+// States that are not recognized are more severe than all recognized states and equally severe to each other.
+func (s HealthStateSeverity) Compare(a, b health.HealthState) int {
+	switch aUnknown, bUnknown := a.IsUnknown(), b.IsUnknown(); {
+	case aUnknown && bUnknown:
+		return 0
+	case aUnknown:
+		return 1
+	case bUnknown:
+		return -1
+	}
+	aRank, bRank := s(a.Value()), s(b.Value())
+	switch {
+	case aRank < bRank:
+		return -1
+	case aRank > bRank:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// WorstState returns the most severe state of all checks in healthStatus, or health.HealthState_HEALTHY if there
+// are no checks.
+func (s HealthStateSeverity) WorstState(healthStatus health.HealthStatus) health.HealthState {
+	return s.Aggregate(healthStatus).State
+}
+
+// Aggregate returns the most severe state of all checks in healthStatus along with the check that determined it.
+func (s HealthStateSeverity) Aggregate(healthStatus health.HealthStatus) AggregateHealthResult {
+	aggregate := AggregateHealthResult{
+		State: health.New_HealthState(health.HealthState_HEALTHY),
+	}
+	for checkType, result := range healthStatus.Checks {
+		if aggregate.DrivingCheck != nil {
+			cmp := s.Compare(result.State, aggregate.State)
+			if cmp < 0 || (cmp == 0 && checkType > aggregate.DrivingCheck.Type) {
+				continue
+			}
+		}
+		driving := result
+		driving.Type = checkType
+		aggregate.State = result.State
+		aggregate.DrivingCheck = &driving
+	}
+	return aggregate
+}
+
+// CompareHealthStates compares a and b using DefaultHealthStateSeverity. It returns -1 if a is less severe than b,
+// 1 if a is more severe than b and 0 if they are equally severe.
+func CompareHealthStates(a, b health.HealthState) int {
+	return HealthStateSeverity(DefaultHealthStateSeverity).Compare(a, b)
+}
+
+// WorstHealthState returns the most severe state of all checks in healthStatus using DefaultHealthStateSeverity, or
+// health.HealthState_HEALTHY if there are no checks.
+func WorstHealthState(healthStatus health.HealthStatus) health.HealthState {
+	return HealthStateSeverity(DefaultHealthStateSeverity).WorstState(healthStatus)
+}
+
+// AggregateHealthStatus returns the most severe state of all checks in healthStatus using
+// DefaultHealthStateSeverity along with the check that determined it.
+func AggregateHealthStatus(healthStatus health.HealthStatus) AggregateHealthResult {
+	return HealthStateSeverity(DefaultHealthStateSeverity).Aggregate(healthStatus)
+}
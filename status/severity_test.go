@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"testing"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareHealthStates(t *testing.T) {
+	values := health.HealthState_Values()
+	for i := range values {
+		for j := range values {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			assert.Equal(t, expected, CompareHealthStates(health.New_HealthState(values[i]), health.New_HealthState(values[j])),
+				"comparing %s and %s", values[i], values[j])
+		}
+	}
+}
+
+func TestAggregateHealthStatus(t *testing.T) {
+	t.Run("no checks", func(t *testing.T) {
+		aggregate := AggregateHealthStatus(health.HealthStatus{})
+		assert.Equal(t, health.HealthState_HEALTHY, aggregate.State.Value())
+		assert.Nil(t, aggregate.DrivingCheck)
+	})
+	t.Run("worst check drives result", func(t *testing.T) {
+		healthStatus := health.HealthStatus{
+			Checks: map[health.CheckType]health.HealthCheckResult{
+				"A_HEALTHY": {Type: "A_HEALTHY", State: health.New_HealthState(health.HealthState_HEALTHY)},
+				"B_ERROR":   {Type: "B_ERROR", State: health.New_HealthState(health.HealthState_ERROR)},
+				"C_ERROR":   {Type: "C_ERROR", State: health.New_HealthState(health.HealthState_ERROR)},
+				"D_WARNING": {Type: "D_WARNING", State: health.New_HealthState(health.HealthState_WARNING)},
+			},
+		}
+		aggregate := AggregateHealthStatus(healthStatus)
+		assert.Equal(t, health.HealthState_ERROR, aggregate.State.Value())
+		require.NotNil(t, aggregate.DrivingCheck)
+		assert.Equal(t, health.CheckType("B_ERROR"), aggregate.DrivingCheck.Type)
+		assert.Equal(t, health.HealthState_ERROR, WorstHealthState(healthStatus).Value())
+	})
+}
+
+func TestCustomHealthStateSeverity(t *testing.T) {
+	severity := NewHealthStateSeverity(
+		health.HealthState_HEALTHY,
+		health.HealthState_WARNING,
+		health.HealthState_DEFERRING,
+	)
+	assert.Equal(t, 1, severity.Compare(health.New_HealthState(health.HealthState_DEFERRING), health.New_HealthState(health.HealthState_WARNING)))
+	assert.Equal(t, 1, severity.Compare(health.New_HealthState(health.HealthState_ERROR), health.New_HealthState(health.HealthState_DEFERRING)))
+	assert.Equal(t, 0, severity.Compare(health.New_HealthState(health.HealthState_ERROR), health.New_HealthState(health.HealthState_TERMINAL)))
+
+	healthStatus := health.HealthStatus{
+		Checks: map[health.CheckType]health.HealthCheckResult{
+			"DEFERRING": {Type: "DEFERRING", State: health.New_HealthState(health.HealthState_DEFERRING)},
+			"WARNING":   {Type: "WARNING", State: health.New_HealthState(health.HealthState_WARNING)},
+		},
+	}
+	assert.Equal(t, health.HealthState_DEFERRING, severity.WorstState(healthStatus).Value())
+	assert.Equal(t, health.HealthState_WARNING, WorstHealthState(healthStatus).Value())
+}
+
+func TestUnknownHealthStateIsMostSevere(t *testing.T) {
+	unknown := health.New_HealthState("FUTURE")
+	for name, severity := range map[string]HealthStateSeverity{
+		"default": DefaultHealthStateSeverity,
+		"custom":  NewHealthStateSeverity(health.HealthState_HEALTHY, health.HealthState_WARNING, health.HealthState_DEFERRING),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, value := range health.HealthState_Values() {
+				state := health.New_HealthState(value)
+				assert.Equal(t, 1, severity.Compare(unknown, state), "comparing with %s", value)
+				assert.Equal(t, -1, severity.Compare(state, unknown), "comparing with %s", value)
+			}
+			assert.Equal(t, 0, severity.Compare(unknown, health.New_HealthState("OTHER")))
+
+			healthStatus := health.HealthStatus{
+				Checks: map[health.CheckType]health.HealthCheckResult{
+					"A_TERMINAL": {Type: "A_TERMINAL", State: health.New_HealthState(health.HealthState_TERMINAL)},
+					"B_UNKNOWN":  {Type: "B_UNKNOWN", State: unknown},
+				},
+			}
+			aggregate := severity.Aggregate(healthStatus)
+			assert.Equal(t, "FUTURE", aggregate.State.String())
+			assert.Equal(t, health.CheckType("B_UNKNOWN"), aggregate.DrivingCheck.Type)
+
+			transitions := severity.Diff(
+				health.HealthStatus{Checks: map[health.CheckType]health.HealthCheckResult{"CHECK": {Type: "CHECK", State: health.New_HealthState(health.HealthState_TERMINAL)}}},
+				health.HealthStatus{Checks: map[health.CheckType]health.HealthCheckResult{"CHECK": {Type: "CHECK", State: unknown}}},
+			)
+			require.Len(t, transitions, 1)
+			assert.Equal(t, Degradation, transitions[0].Direction)
+		})
+	}
+	assert.Greater(t, DefaultHealthStateSeverity("FUTURE"), DefaultHealthStateSeverity(health.HealthState_TERMINAL))
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+)
+
+// HealthStatusSnapshot is a health status along with the generation of the source that it is at least as recent as.
+type HealthStatusSnapshot struct {
+	Generation   uint64
+	HealthStatus health.HealthStatus
+}
+
+// SnapshotHealthCheckSource is a HealthCheckSource that versions its health status. The generation of the source
+// increases whenever its health status may have changed. The health status of a snapshot is at least as recent as its
+// generation but may already include changes made concurrently with taking it, so two snapshots with the same
+// generation can have different health statuses. An unchanged generation only indicates that no change completed since
+// the earlier snapshot was taken.
+type SnapshotHealthCheckSource interface {
+	HealthCheckSource
+	// Generation returns the current generation of the source without computing its health status.
+	Generation() uint64
+	// HealthStatusSnapshot returns the health status of the source along with its generation. The health status is at
+	// least as recent as the generation.
+	HealthStatusSnapshot(ctx context.Context) HealthStatusSnapshot
+}
@@ -44,31 +44,6 @@ type HealthCheckSource interface {
 	HealthStatus(ctx context.Context) health.HealthStatus
 }
 
-type combinedHealthCheckSource struct {
-	healthCheckSources []HealthCheckSource
-}
-
-func NewCombinedHealthCheckSource(healthCheckSources ...HealthCheckSource) HealthCheckSource {
-	return &combinedHealthCheckSource{
-		healthCheckSources: healthCheckSources,
-	}
-}
-
-func (c *combinedHealthCheckSource) HealthStatus(ctx context.Context) health.HealthStatus {
-	result := health.HealthStatus{
-		Checks: map[health.CheckType]health.HealthCheckResult{},
-	}
-	for _, healthCheckSource := range c.healthCheckSources {
-		if healthCheckSource == nil {
-			continue
-		}
-		for k, v := range healthCheckSource.HealthStatus(ctx).Checks {
-			result.Checks[k] = v
-		}
-	}
-	return result
-}
-
 // HealthStateStatusCode returns the http status code for the provided health.HealthState_Value or
 // http.StatusInternalServerError if the health state value is not recognized.
 func HealthStateStatusCode(state health.HealthState_Value) int {
@@ -79,6 +54,17 @@ func HealthStateStatusCode(state health.HealthState_Value) int {
 	return code
 }
 
+// HealthStateFromStatusCode returns the health.HealthState_Value that HealthStateStatusCode maps to the provided http
+// status code. Returns false if no health state maps to the status code.
+func HealthStateFromStatusCode(code int) (health.HealthState_Value, bool) {
+	for state, stateCode := range healthStateStatusCodes {
+		if stateCode == code {
+			return state, true
+		}
+	}
+	return "", false
+}
+
 func HealthStatusCode(metadata health.HealthStatus) int {
 	worst := http.StatusOK
 	for _, result := range metadata.Checks {
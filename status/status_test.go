@@ -16,6 +16,7 @@ package status
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
@@ -71,3 +72,15 @@ func TestCombinedHealthCheckSource(t *testing.T) {
 		},
 	}, actual)
 }
+
+func TestHealthStateFromStatusCode(t *testing.T) {
+	for _, state := range health.HealthState_Values() {
+		actual, ok := HealthStateFromStatusCode(HealthStateStatusCode(state))
+		assert.True(t, ok)
+		assert.Equal(t, state, actual)
+	}
+	_, ok := HealthStateFromStatusCode(http.StatusInternalServerError)
+	assert.False(t, ok)
+	_, ok = HealthStateFromStatusCode(http.StatusNotFound)
+	assert.False(t, ok)
+}
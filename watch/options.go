@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+)
+
+type Option func(conf *watcherConfig)
+
+const defaultBufferSize = 64
+
+type watcherConfig struct {
+	bufferSize int
+	severity   status.HealthStateSeverity
+}
+
+func defaultWatcherConfig() watcherConfig {
+	return watcherConfig{
+		bufferSize: defaultBufferSize,
+		severity:   status.DefaultHealthStateSeverity,
+	}
+}
+
+func (w *watcherConfig) apply(options ...Option) {
+	for _, option := range options {
+		option(w)
+	}
+}
+
+// WithBufferSize sets the number of events buffered for each subscriber. Events delivered to a subscriber whose
+// buffer is full are dropped.
+func WithBufferSize(bufferSize int) Option {
+	return func(conf *watcherConfig) {
+		conf.bufferSize = bufferSize
+	}
+}
+
+// WithHealthStateSeverity sets the severity ordering used to determine the direction of transitions.
+func WithHealthStateSeverity(severity status.HealthStateSeverity) Option {
+	return func(conf *watcherConfig) {
+		conf.severity = severity
+	}
+}
+
+// Filter returns whether an event should be delivered to a subscriber.
+type Filter func(event Event) bool
+
+// CheckTypes returns a Filter that accepts events for the provided check types.
+func CheckTypes(checkTypes ...health.CheckType) Filter {
+	accepted := make(map[health.CheckType]struct{}, len(checkTypes))
+	for _, checkType := range checkTypes {
+		accepted[checkType] = struct{}{}
+	}
+	return func(event Event) bool {
+		_, ok := accepted[event.CheckType]
+		return ok
+	}
+}
+
+// Directions returns a Filter that accepts events whose transitions have one of the provided directions.
+func Directions(directions ...status.TransitionDirection) Filter {
+	return func(event Event) bool {
+		for _, direction := range directions {
+			if event.Direction == direction {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// StateChanges returns a Filter that accepts events whose transitions changed the state of a check, including checks
+// that were added or removed.
+func StateChanges() Filter {
+	return func(event Event) bool {
+		return event.StateChanged
+	}
+}
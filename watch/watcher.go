@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+)
+
+// Event is a transition of a single check observed by a Watcher.
+type Event struct {
+	status.CheckTransition
+	// Time is when the health status containing the transition was sampled.
+	Time time.Time
+}
+
+// Watcher periodically samples a health check source and delivers the transitions between consecutive samples to
+// its subscribers. The first sample is used as a baseline and does not produce events.
+type Watcher struct {
+	source     status.HealthCheckSource
+	interval   time.Duration
+	bufferSize int
+	severity   status.HealthStateSeverity
+	logger     svc1log.Logger
+
+	// mutex protects access to `subscriptions`, `nextID`, `latest` and `closed`.
+	mutex         sync.Mutex
+	subscriptions map[int]*subscription
+	nextID        int
+	latest        *health.HealthStatus
+	closed        bool
+}
+
+type subscription struct {
+	events  chan Event
+	filters []Filter
+}
+
+func MustNewWatcher(ctx context.Context, source status.HealthCheckSource, interval time.Duration, options ...Option) *Watcher {
+	watcher, err := NewWatcher(ctx, source, interval, options...)
+	if err != nil {
+		panic(err)
+	}
+	return watcher
+}
+
+// NewWatcher returns a Watcher that samples source every interval until ctx is done, at which point all subscriber
+// channels are closed.
+func NewWatcher(ctx context.Context, source status.HealthCheckSource, interval time.Duration, options ...Option) (*Watcher, error) {
+	conf := defaultWatcherConfig()
+	conf.apply(options...)
+
+	if interval <= 0 {
+		return nil, werror.Error("interval must be positive",
+			werror.SafeParam("interval", interval.String()))
+	}
+	if conf.bufferSize < 0 {
+		return nil, werror.Error("bufferSize cannot be negative",
+			werror.SafeParam("bufferSize", conf.bufferSize))
+	}
+	watcher := &Watcher{
+		source:        source,
+		interval:      interval,
+		bufferSize:    conf.bufferSize,
+		severity:      conf.severity,
+		logger:        svc1log.FromContext(ctx),
+		subscriptions: make(map[int]*subscription),
+	}
+	go wapp.RunWithRecoveryLogging(ctx, watcher.runWatch)
+	return watcher, nil
+}
+
+// Subscribe returns a channel on which events accepted by all provided filters are delivered, along with a function
+// that cancels the subscription and closes the channel. The channel is also closed when the Watcher stops.
+func (w *Watcher) Subscribe(filters ...Filter) (<-chan Event, func()) {
+	events := make(chan Event, w.bufferSize)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.closed {
+		close(events)
+		return events, func() {}
+	}
+	id := w.nextID
+	w.nextID++
+	w.subscriptions[id] = &subscription{
+		events:  events,
+		filters: filters,
+	}
+	return events, func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		if sub, ok := w.subscriptions[id]; ok {
+			delete(w.subscriptions, id)
+			close(sub.events)
+		}
+	}
+}
+
+// SubscribeFunc calls fn with every event accepted by all provided filters and returns a function that cancels the
+// subscription. Events are delivered in order on a dedicated goroutine, so fn does not block the Watcher.
+func (w *Watcher) SubscribeFunc(fn func(event Event), filters ...Filter) func() {
+	events, unsubscribe := w.Subscribe(filters...)
+	go func() {
+		for event := range events {
+			w.callSubscriber(fn, event)
+		}
+	}()
+	return unsubscribe
+}
+
+// Latest returns the most recently sampled health status, or false if the source has not yet been sampled.
+func (w *Watcher) Latest() (health.HealthStatus, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.latest == nil {
+		return health.HealthStatus{}, false
+	}
+	return *w.latest, true
+}
+
+func (w *Watcher) runWatch(ctx context.Context) {
+	defer w.close()
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	w.sample(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// ensure that sample is not called if context is cancelled
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			w.sample(ctx)
+		}
+	}
+}
+
+// sample calls the source and delivers the transitions since the previous sample. A panic of the source or of a
+// filter is recovered and logged so that it does not stop the Watcher; a sample in which the source panicked is
+// skipped.
+func (w *Watcher) sample(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.logger.Error("Recovered from panic while sampling health check source.",
+				svc1log.UnsafeParam("recovered", r))
+		}
+	}()
+	healthStatus := w.source.HealthStatus(ctx)
+	sampleTime := time.Now()
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	previous := w.latest
+	w.latest = &healthStatus
+	if previous == nil || w.closed {
+		return
+	}
+	for _, transition := range w.severity.Diff(*previous, healthStatus) {
+		event := Event{
+			CheckTransition: transition,
+			Time:            sampleTime,
+		}
+		for _, sub := range w.subscriptions {
+			if !sub.accepts(event) {
+				continue
+			}
+			select {
+			case sub.events <- event:
+			default:
+				w.logger.Warn("Dropped health transition event for slow subscriber.",
+					svc1log.SafeParam("checkType", event.CheckType))
+			}
+		}
+	}
+}
+
+func (w *Watcher) close() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.closed = true
+	for id, sub := range w.subscriptions {
+		delete(w.subscriptions, id)
+		close(sub.events)
+	}
+}
+
+func (s *subscription) accepts(event Event) bool {
+	for _, filter := range s.filters {
+		if !filter(event) {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *Watcher) callSubscriber(fn func(event Event), event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.logger.Error("Recovered from panic in health transition subscriber.",
+				svc1log.SafeParam("checkType", event.CheckType),
+				svc1log.UnsafeParam("recovered", r))
+		}
+	}()
+	fn(event)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-health/conjure/witchcraft/api/health"
+	"github.com/palantir/witchcraft-go-health/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mutableHealthCheckSource struct {
+	mutex     sync.Mutex
+	states    map[health.CheckType]health.HealthState_Value
+	panicking bool
+}
+
+func (m *mutableHealthCheckSource) HealthStatus(_ context.Context) health.HealthStatus {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.panicking {
+		panic("source failed")
+	}
+	checks := make(map[health.CheckType]health.HealthCheckResult, len(m.states))
+	for checkType, state := range m.states {
+		checks[checkType] = health.HealthCheckResult{
+			Type:  checkType,
+			State: health.New_HealthState(state),
+		}
+	}
+	return health.HealthStatus{Checks: checks}
+}
+
+func (m *mutableHealthCheckSource) set(checkType health.CheckType, state health.HealthState_Value) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.states[checkType] = state
+}
+
+func (m *mutableHealthCheckSource) setPanicking(panicking bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.panicking = panicking
+}
+
+func newTestWatcher(t *testing.T, ctx context.Context) (*mutableHealthCheckSource, *Watcher) {
+	source := &mutableHealthCheckSource{
+		states: map[health.CheckType]health.HealthState_Value{
+			"FIRST":  health.HealthState_HEALTHY,
+			"SECOND": health.HealthState_HEALTHY,
+		},
+	}
+	watcher, err := NewWatcher(ctx, source, 5*time.Millisecond)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, ok := watcher.Latest()
+		return ok
+	}, time.Second, time.Millisecond)
+	return source, watcher
+}
+
+func receive(t *testing.T, events <-chan Event) Event {
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "channel closed")
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for event")
+		return Event{}
+	}
+}
+
+func TestWatcher_Subscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source, watcher := newTestWatcher(t, ctx)
+
+	all, unsubscribeAll := watcher.Subscribe()
+	defer unsubscribeAll()
+	degradations, unsubscribeDegradations := watcher.Subscribe(Directions(status.Degradation))
+	defer unsubscribeDegradations()
+	second, unsubscribeSecond := watcher.Subscribe(CheckTypes("SECOND"))
+	defer unsubscribeSecond()
+
+	source.set("FIRST", health.HealthState_ERROR)
+	event := receive(t, all)
+	assert.Equal(t, health.CheckType("FIRST"), event.CheckType)
+	assert.Equal(t, status.Degradation, event.Direction)
+	assert.Equal(t, health.HealthState_HEALTHY, event.OldState.Value())
+	assert.Equal(t, health.HealthState_ERROR, event.NewState.Value())
+	assert.Equal(t, health.CheckType("FIRST"), receive(t, degradations).CheckType)
+
+	source.set("FIRST", health.HealthState_HEALTHY)
+	event = receive(t, all)
+	assert.Equal(t, status.Recovery, event.Direction)
+
+	source.set("SECOND", health.HealthState_WARNING)
+	assert.Equal(t, health.CheckType("SECOND"), receive(t, all).CheckType)
+	assert.Equal(t, health.CheckType("SECOND"), receive(t, degradations).CheckType)
+	assert.Equal(t, health.CheckType("SECOND"), receive(t, second).CheckType)
+}
+
+func TestWatcher_SubscribeFunc(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source, watcher := newTestWatcher(t, ctx)
+
+	received := make(chan Event, 10)
+	unsubscribe := watcher.SubscribeFunc(func(event Event) {
+		received <- event
+	}, StateChanges())
+	defer unsubscribe()
+
+	source.set("FIRST", health.HealthState_WARNING)
+	event := receive(t, received)
+	assert.Equal(t, health.CheckType("FIRST"), event.CheckType)
+}
+
+func TestWatcher_SourcePanic(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source, watcher := newTestWatcher(t, ctx)
+	events, unsubscribe := watcher.Subscribe()
+	defer unsubscribe()
+
+	source.setPanicking(true)
+	time.Sleep(20 * time.Millisecond)
+	source.set("FIRST", health.HealthState_ERROR)
+	source.setPanicking(false)
+
+	event := receive(t, events)
+	assert.Equal(t, health.CheckType("FIRST"), event.CheckType)
+	assert.Equal(t, health.HealthState_HEALTHY, event.OldState.Value())
+	assert.Equal(t, health.HealthState_ERROR, event.NewState.Value())
+}
+
+func TestWatcher_ClosesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, watcher := newTestWatcher(t, ctx)
+	events, unsubscribe := watcher.Subscribe()
+	defer unsubscribe()
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		assert.Fail(t, "channel was not closed")
+	}
+
+	events, _ = watcher.Subscribe()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestWatcher_Unsubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, watcher := newTestWatcher(t, ctx)
+	events, unsubscribe := watcher.Subscribe()
+	unsubscribe()
+	unsubscribe()
+	_, ok := <-events
+	assert.False(t, ok)
+}